@@ -1,10 +1,16 @@
 package main
 
 import (
+	"context"
 	"net/http"
+	"os"
+	"time"
 
+	"github.com/bear-belly/mcp-registry/internal/blob"
+	"github.com/bear-belly/mcp-registry/internal/license"
 	"github.com/bear-belly/mcp-registry/internal/logger"
 	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/risk"
 	"github.com/bear-belly/mcp-registry/internal/server"
 	"github.com/bear-belly/mcp-registry/internal/storage"
 	"github.com/bear-belly/mcp-registry/internal/templates"
@@ -20,11 +26,68 @@ func main() {
 		StoragePath:  "./data",
 		TemplatePath: "./internal/templates",
 		LogLevel:     "INFO",
+		AdminToken:   os.Getenv("MCP_REGISTRY_ADMIN_TOKEN"),
+
+		BlobStoreType:     "local",
+		BlobPath:          "./blobs",
+		MaxAttachmentSize: 25 << 20,
+
+		RegistryNamespace: "com.ourcompany",
+
+		IdempotencyWindow: 24 * time.Hour,
 	}
 
 	// initialise a global logger, based on slog but abstracted to change easily later
 	logger.NewLogger(config)
 
+	if window := os.Getenv("MCP_REGISTRY_IDEMPOTENCY_WINDOW"); window != "" {
+		duration, err := time.ParseDuration(window)
+		if err != nil || duration <= 0 {
+			logger.Error("MCP_REGISTRY_IDEMPOTENCY_WINDOW must be a positive duration such as 24h", "value", window)
+			return
+		}
+		config.IdempotencyWindow = duration
+	}
+
+	// v1 of the server API is supported until a deprecation date is set
+	for name, date := range map[string]*time.Time{
+		"MCP_REGISTRY_V1_DEPRECATED": &config.V1Deprecated,
+		"MCP_REGISTRY_V1_SUNSET":     &config.V1Sunset,
+	} {
+		if value := os.Getenv(name); value != "" {
+			parsed, err := time.Parse(time.DateOnly, value)
+			if err != nil {
+				logger.Error(name+" must be a date such as 2026-11-01", "value", value)
+				return
+			}
+			*date = parsed
+		}
+	}
+	if config.V1Deprecated.IsZero() && !config.V1Sunset.IsZero() {
+		logger.Error("MCP_REGISTRY_V1_SUNSET requires MCP_REGISTRY_V1_DEPRECATED")
+		return
+	}
+
+	if config.AdminToken == "" {
+		logger.Warn("MCP_REGISTRY_ADMIN_TOKEN is not set, admin API routes are open to everyone")
+	}
+
+	// load the weights used to compute risk scores
+	weights, err := risk.LoadWeights(config.RiskWeightsPath)
+	if err != nil {
+		logger.Error("Could not load risk weights", err)
+		return
+	}
+	config.RiskWeights = weights
+
+	// load the license policy enforced when servers are approved
+	licensePolicy, err := license.LoadPolicy(config.LicensePolicyPath)
+	if err != nil {
+		logger.Error("Could not load license policy", err)
+		return
+	}
+	config.LicensePolicy = licensePolicy
+
 	// create a storage interface using the factory pattern
 	logger.Info("Configuring storage...")
 	storage, err := storage.NewStorage(config)
@@ -33,6 +96,14 @@ func main() {
 		return
 	}
 
+	// attachments live in a blob store of their own
+	logger.Info("Configuring blob store...")
+	blobs, err := blob.NewStore(config)
+	if err != nil {
+		logger.Error("Could not start due to error in the blob store", err)
+		return
+	}
+
 	// initialise page templates
 	logger.Info("Configuring templater...")
 	err = templates.InitTemplates(config)
@@ -42,11 +113,20 @@ func main() {
 	}
 
 	// create and configure HTTP server
-	server := server.New(storage, config)
+	server := server.New(storage, blobs, config)
 	server.SetupRoutes()
+	server.StartWebhooks(context.Background())
+	server.StartIdempotencyCleanup(context.Background())
+
+	// gRPC needs HTTP/2, which clients speak without TLS when the server
+	// accepts it, so the RPCs can share the port of the HTTP API
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	httpServer := &http.Server{Addr: ":8088", Handler: server.Handler(), Protocols: protocols}
 
 	logger.Info("Starting server on :8088")
-	if err := http.ListenAndServe(":8088", server.Handler()); err != nil {
+	if err := httpServer.ListenAndServe(); err != nil {
 		logger.Error("Server failed to start: ", err)
 	}
 }
@@ -1,3 +1,11 @@
 module github.com/bear-belly/mcp-registry
 
 go 1.24.3
+
+require (
+	connectrpc.com/connect v1.18.1
+	connectrpc.com/grpcreflect v1.3.0
+	github.com/andybalholm/brotli v1.2.0
+	github.com/graphql-go/graphql v0.8.1
+	google.golang.org/protobuf v1.36.12
+)
@@ -0,0 +1,85 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+)
+
+func newTestStore(t *testing.T) *LocalStore {
+	t.Helper()
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("creating store: %v", err)
+	}
+	return store
+}
+
+func TestIngest_StoresContentUnderItsHash(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+	content := []byte("%PDF-1.7\nsecurity review\n")
+
+	info, err := Ingest(ctx, store, bytes.NewReader(content), 1024)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	sum := sha256.Sum256(content)
+	if info.Key != hex.EncodeToString(sum[:]) {
+		t.Errorf("expected key to be the SHA-256 of the content, got %s", info.Key)
+	}
+	if info.Size != int64(len(content)) {
+		t.Errorf("expected size %d, got %d", len(content), info.Size)
+	}
+	if info.ContentType != "application/pdf" {
+		t.Errorf("expected sniffed type application/pdf, got %s", info.ContentType)
+	}
+
+	reader, err := store.Open(ctx, info.Key)
+	if err != nil {
+		t.Fatalf("expected stored content, got %v", err)
+	}
+	defer reader.Close()
+	stored, _ := io.ReadAll(reader)
+	if !bytes.Equal(stored, content) {
+		t.Error("expected stored content to match the upload")
+	}
+}
+
+func TestIngest_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		content []byte
+		status  int
+	}{
+		{"too large", []byte(strings.Repeat("a", 65)), http.StatusRequestEntityTooLarge},
+		{"empty", nil, http.StatusBadRequest},
+		{"executable", []byte("MZ\x90\x00\x03\x00\x00\x00"), http.StatusUnsupportedMediaType},
+		{"html", []byte("<!DOCTYPE html><script>alert(1)</script>"), http.StatusUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Ingest(context.Background(), newTestStore(t), bytes.NewReader(tt.content), 64)
+			appErr, ok := err.(*errors.AppError)
+			if !ok || appErr.StatusCode != tt.status {
+				t.Errorf("expected status %d, got %v", tt.status, err)
+			}
+		})
+	}
+}
+
+func TestLocalStore_RejectsKeysOutsideTheStore(t *testing.T) {
+	store := newTestStore(t)
+	if _, err := store.Open(context.Background(), "../data/secret"); err == nil {
+		t.Error("expected key with a path to be rejected")
+	}
+}
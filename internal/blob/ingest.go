@@ -0,0 +1,85 @@
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"slices"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+)
+
+// AllowedContentTypes lists the media types accepted as attachments. The type
+// is sniffed from the content, never taken from the client. JSON and SPDX or
+// CycloneDX documents sniff as text/plain or text/xml.
+var AllowedContentTypes = []string{
+	"application/pdf",
+	"application/zip",
+	"image/jpeg",
+	"image/png",
+	"text/plain",
+	"text/xml",
+}
+
+// Info describes content that has been ingested into a store
+type Info struct {
+	Key         string
+	Size        int64
+	ContentType string
+}
+
+// Ingest hashes, size-checks and sniffs content, then puts it in the store
+// under its SHA-256 digest. The content is spooled to a temporary file so
+// that large uploads are never held in memory.
+func Ingest(ctx context.Context, store Store, content io.Reader, maxSize int64) (Info, error) {
+	tmp, err := os.CreateTemp("", "mcp-registry-blob-*")
+	if err != nil {
+		return Info{}, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(content, maxSize+1))
+	if err != nil {
+		return Info{}, err
+	}
+	if size > maxSize {
+		return Info{}, errors.NewBadRequestError(fmt.Sprintf("Attachment exceeds %d bytes", maxSize)).
+			SetStatusCode(http.StatusRequestEntityTooLarge)
+	}
+	if size == 0 {
+		return Info{}, errors.NewBadRequestError("Attachment is empty")
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return Info{}, err
+	}
+	head := make([]byte, 512)
+	n, err := io.ReadFull(tmp, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return Info{}, err
+	}
+
+	contentType := http.DetectContentType(head[:n])
+	if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || !slices.Contains(AllowedContentTypes, mediaType) {
+		return Info{}, errors.NewBadRequestError(fmt.Sprintf("Attachments of type %s are not accepted", contentType)).
+			SetStatusCode(http.StatusUnsupportedMediaType)
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return Info{}, err
+	}
+
+	info := Info{Key: hex.EncodeToString(hash.Sum(nil)), Size: size, ContentType: contentType}
+	if err := store.Put(ctx, info.Key, tmp); err != nil {
+		return Info{}, err
+	}
+
+	return info, nil
+}
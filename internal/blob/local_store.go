@@ -0,0 +1,76 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+)
+
+// LocalStore keeps blobs as files in a local directory
+type LocalStore struct {
+	Path string
+}
+
+func NewLocalStore(path string) (*LocalStore, error) {
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return nil, fmt.Errorf("creating blob directory: %w", err)
+	}
+	return &LocalStore{Path: path}, nil
+}
+
+func (ls *LocalStore) Put(ctx context.Context, key string, content io.Reader) error {
+	if !ValidKey(key) {
+		return errors.NewBadRequestError("Invalid blob key")
+	}
+
+	path := filepath.Join(ls.Path, key)
+	if _, err := os.Stat(path); err == nil {
+		// Same key, same content
+		return nil
+	}
+
+	// Write to a temporary file first so that readers never see partial content
+	tmp, err := os.CreateTemp(ls.Path, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (ls *LocalStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	if !ValidKey(key) {
+		return nil, errors.NewNotFoundError("Attachment")
+	}
+
+	file, err := os.Open(filepath.Join(ls.Path, key))
+	if os.IsNotExist(err) {
+		return nil, errors.NewNotFoundError("Attachment")
+	}
+	return file, err
+}
+
+func (ls *LocalStore) Delete(ctx context.Context, key string) error {
+	if !ValidKey(key) {
+		return errors.NewNotFoundError("Attachment")
+	}
+
+	err := os.Remove(filepath.Join(ls.Path, key))
+	if os.IsNotExist(err) {
+		return errors.NewNotFoundError("Attachment")
+	}
+	return err
+}
@@ -0,0 +1,41 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// Store keeps attachment content. Blobs are addressed by the hex SHA-256 of
+// their content, so identical files are stored once and a key always refers
+// to the same bytes.
+type Store interface {
+	// Put stores the content under key, replacing nothing if it exists
+	Put(ctx context.Context, key string, content io.Reader) error
+	// Open returns the content stored under key
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the content stored under key
+	Delete(ctx context.Context, key string) error
+}
+
+// keyPattern matches a hex SHA-256 digest; anything else could escape the
+// store's location
+var keyPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// ValidKey reports whether key is a well-formed blob key
+func ValidKey(key string) bool {
+	return keyPattern.MatchString(key)
+}
+
+// NewStore creates the blob store selected by the configuration
+func NewStore(config models.Config) (Store, error) {
+	switch config.BlobStoreType {
+	case "", "local":
+		return NewLocalStore(config.BlobPath)
+	}
+
+	return nil, fmt.Errorf("Unknown blob store %q", config.BlobStoreType)
+}
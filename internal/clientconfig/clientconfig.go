@@ -0,0 +1,318 @@
+// Package clientconfig renders the config entries of a server into the
+// configuration file format of each MCP host application. String values keep
+// their ${input:name} placeholders: VS Code resolves them itself from the
+// inputs section, for the other hosts the user fills them in.
+package clientconfig
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// Host identifiers
+const (
+	ClaudeDesktop = "claude-desktop"
+	ClaudeCode    = "claude-code"
+	VSCode        = "vscode"
+	Cursor        = "cursor"
+	Windsurf      = "windsurf"
+)
+
+// Host describes an MCP host application and where it reads its config
+type Host struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	File string `json:"file"`
+}
+
+// Hosts lists every supported host in the order they are shown
+var Hosts = []Host{
+	{ID: ClaudeDesktop, Name: "Claude Desktop", File: "claude_desktop_config.json"},
+	{ID: ClaudeCode, Name: "Claude Code", File: ".mcp.json"},
+	{ID: VSCode, Name: "VS Code", File: ".vscode/mcp.json"},
+	{ID: Cursor, Name: "Cursor", File: ".cursor/mcp.json"},
+	{ID: Windsurf, Name: "Windsurf", File: "~/.codeium/windsurf/mcp_config.json"},
+}
+
+// HostIDs lists the identifiers of the supported hosts
+func HostIDs() []string {
+	ids := make([]string, 0, len(Hosts))
+	for _, host := range Hosts {
+		ids = append(ids, host.ID)
+	}
+	return ids
+}
+
+// FindHost returns the host with the given identifier
+func FindHost(id string) (Host, bool) {
+	for _, host := range Hosts {
+		if host.ID == id {
+			return host, true
+		}
+	}
+	return Host{}, false
+}
+
+// Document is a generated configuration file for one host
+type Document struct {
+	Host
+	Config Config `json:"config"`
+
+	// Placeholders lists the inputs left as ${input:name} for the user to
+	// fill in. It is empty for VS Code, which prompts for them.
+	Placeholders []string `json:"placeholders,omitempty"`
+}
+
+// Config is the content of a host configuration file. VS Code keeps its
+// servers under servers next to the inputs it prompts for; every other host
+// uses mcpServers.
+type Config struct {
+	Inputs     []PromptInput    `json:"inputs,omitempty"`
+	Servers    map[string]Entry `json:"servers,omitempty"`
+	MCPServers map[string]Entry `json:"mcpServers,omitempty"`
+}
+
+// PromptInput is a VS Code input variable
+type PromptInput struct {
+	Type        string `json:"type"`
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Password    bool   `json:"password,omitempty"`
+}
+
+// Entry is a single server in a host configuration. Local servers use
+// Command; remote servers use URL, or ServerURL for Windsurf.
+type Entry struct {
+	Type      string            `json:"type,omitempty"`
+	Command   string            `json:"command,omitempty"`
+	Args      []string          `json:"args,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	URL       string            `json:"url,omitempty"`
+	ServerURL string            `json:"serverUrl,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+}
+
+// Generate renders a server's config entries for a host
+func Generate(host Host, server models.Server) (Document, error) {
+	doc := Document{Host: host}
+
+	entries := map[string]Entry{}
+	for _, name := range sortedNames(server.Config) {
+		source, ok := server.Config[name].(map[string]interface{})
+		if !ok {
+			return Document{}, fmt.Errorf("config entry %q is not an object", name)
+		}
+		entries[name] = render(host.ID, server.TransportType(), readEntry(source))
+	}
+
+	if host.ID == VSCode {
+		doc.Config.Servers = entries
+		doc.Config.Inputs = promptInputs(server)
+	} else {
+		doc.Config.MCPServers = entries
+		doc.Placeholders = referencedInputs(server)
+	}
+
+	return doc, nil
+}
+
+// GenerateAll renders a server's config entries for every host
+func GenerateAll(server models.Server) ([]Document, error) {
+	docs := make([]Document, 0, len(Hosts))
+	for _, host := range Hosts {
+		doc, err := Generate(host, server)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// source is a config entry as stored in the catalog
+type source struct {
+	command string
+	args    []string
+	env     map[string]string
+	url     string
+	headers map[string]string
+}
+
+func readEntry(entry map[string]interface{}) source {
+	command, _ := entry["command"].(string)
+	address, _ := entry["url"].(string)
+	return source{
+		command: command,
+		args:    stringList(entry["args"]),
+		env:     stringMap(entry["env"]),
+		url:     address,
+		headers: stringMap(entry["headers"]),
+	}
+}
+
+// render converts an entry for a host. Hosts that connect to remote servers
+// themselves get the URL, even when the catalog entry bridges to it with
+// mcp-remote; Claude Desktop only launches local processes, so it gets the
+// bridge.
+func render(host, transport string, entry source) Entry {
+	if entry.url == "" {
+		if address, ok := bridgedURL(entry); ok && host != ClaudeDesktop {
+			entry = source{url: address}
+		}
+	}
+
+	if entry.url == "" {
+		local := Entry{Command: entry.command, Args: entry.args, Env: entry.env}
+		if host == ClaudeCode || host == VSCode {
+			local.Type = "stdio"
+		}
+		return local
+	}
+
+	remoteType := "http"
+	if transport == models.TransportSSE {
+		remoteType = "sse"
+	}
+
+	switch host {
+	case ClaudeDesktop:
+		return bridge(entry, remoteType)
+	case ClaudeCode, VSCode:
+		return Entry{Type: remoteType, URL: entry.url, Headers: entry.headers}
+	case Windsurf:
+		return Entry{ServerURL: entry.url, Headers: entry.headers}
+	}
+	return Entry{URL: entry.url, Headers: entry.headers}
+}
+
+// bridge launches mcp-remote to reach a remote server. Header values are
+// passed through environment variables, which mcp-remote expands in its
+// arguments, so that values with spaces survive every platform's shell.
+func bridge(entry source, remoteType string) Entry {
+	local := Entry{Command: "npx", Args: []string{"-y", "mcp-remote", entry.url}}
+	if remoteType == "sse" {
+		local.Args = append(local.Args, "--transport", "sse-only")
+	}
+
+	for _, name := range sortedNames(entry.headers) {
+		variable := headerVariable(name)
+		local.Args = append(local.Args, "--header", name+":${"+variable+"}")
+		if local.Env == nil {
+			local.Env = map[string]string{}
+		}
+		local.Env[variable] = entry.headers[name]
+	}
+
+	return local
+}
+
+// bridgedURL recognises an entry that does nothing but run mcp-remote
+// against a URL
+func bridgedURL(entry source) (string, bool) {
+	args := entry.args
+	if entry.command != "npx" || len(entry.env) > 0 {
+		return "", false
+	}
+	if len(args) > 0 && args[0] == "-y" {
+		args = args[1:]
+	}
+	if len(args) != 2 || (args[0] != "mcp-remote" && !strings.HasPrefix(args[0], "mcp-remote@")) {
+		return "", false
+	}
+	return args[1], true
+}
+
+func headerVariable(name string) string {
+	return "MCP_HEADER_" + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'):
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// promptInputs declares the inputs the config references as VS Code input
+// variables, which share the ${input:name} syntax of the catalog
+func promptInputs(server models.Server) []PromptInput {
+	var inputs []PromptInput
+	for _, name := range referencedInputs(server) {
+		declared, _ := server.FindInput(name)
+		inputs = append(inputs, PromptInput{
+			Type:        "promptString",
+			ID:          name,
+			Description: declared.Description,
+			Default:     declared.Default,
+			Password:    declared.Secret,
+		})
+	}
+	return inputs
+}
+
+// referencedInputs lists the inputs the config references in the order they
+// are declared, followed by any undeclared ones by name. The config is a map,
+// so the order of the references themselves means nothing.
+func referencedInputs(server models.Server) []string {
+	referenced := server.InputReferences()
+
+	var names []string
+	for _, input := range server.Inputs {
+		if slices.Contains(referenced, input.Name) {
+			names = append(names, input.Name)
+		}
+	}
+
+	var undeclared []string
+	for _, name := range referenced {
+		if !slices.Contains(names, name) {
+			undeclared = append(undeclared, name)
+		}
+	}
+	sort.Strings(undeclared)
+
+	return append(names, undeclared...)
+}
+
+func stringList(value interface{}) []string {
+	items, _ := value.([]interface{})
+	if len(items) == 0 {
+		return nil
+	}
+	list := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+func stringMap(value interface{}) map[string]string {
+	m, _ := value.(map[string]interface{})
+	if len(m) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for key, item := range m {
+		if s, ok := item.(string); ok {
+			out[key] = s
+		}
+	}
+	return out
+}
+
+func sortedNames[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,167 @@
+package clientconfig
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+var remoteServer = models.Server{
+	Transport: "SSE",
+	Inputs:    []models.Input{{Name: "token", Description: "API token", Secret: true}},
+	Config: map[string]interface{}{
+		"idp": map[string]interface{}{
+			"url":     "https://idp.example.com/mcp",
+			"headers": map[string]interface{}{"Authorization": "Bearer ${input:token}"},
+		},
+	},
+}
+
+func generate(t *testing.T, id string, server models.Server) Document {
+	t.Helper()
+	host, ok := FindHost(id)
+	if !ok {
+		t.Fatalf("unknown host %q", id)
+	}
+	doc, err := Generate(host, server)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return doc
+}
+
+func TestGenerate_RemoteServer(t *testing.T) {
+	headers := map[string]string{"Authorization": "Bearer ${input:token}"}
+
+	cases := map[string]Entry{
+		ClaudeCode: {Type: "sse", URL: "https://idp.example.com/mcp", Headers: headers},
+		VSCode:     {Type: "sse", URL: "https://idp.example.com/mcp", Headers: headers},
+		Cursor:     {URL: "https://idp.example.com/mcp", Headers: headers},
+		Windsurf:   {ServerURL: "https://idp.example.com/mcp", Headers: headers},
+		ClaudeDesktop: {
+			Command: "npx",
+			Args: []string{"-y", "mcp-remote", "https://idp.example.com/mcp", "--transport", "sse-only",
+				"--header", "Authorization:${MCP_HEADER_AUTHORIZATION}"},
+			Env: map[string]string{"MCP_HEADER_AUTHORIZATION": "Bearer ${input:token}"},
+		},
+	}
+
+	for id, want := range cases {
+		doc := generate(t, id, remoteServer)
+		entries := doc.Config.MCPServers
+		if id == VSCode {
+			entries = doc.Config.Servers
+		}
+		if got := entries["idp"]; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: expected %+v, got %+v", id, want, got)
+		}
+	}
+}
+
+func TestGenerate_VSCodePromptsForInputs(t *testing.T) {
+	doc := generate(t, VSCode, remoteServer)
+
+	want := []PromptInput{{Type: "promptString", ID: "token", Description: "API token", Password: true}}
+	if !reflect.DeepEqual(doc.Config.Inputs, want) {
+		t.Errorf("expected inputs %+v, got %+v", want, doc.Config.Inputs)
+	}
+	if doc.Config.MCPServers != nil || len(doc.Placeholders) != 0 {
+		t.Errorf("expected only servers and inputs, got %+v", doc)
+	}
+
+	if other := generate(t, Cursor, remoteServer); !reflect.DeepEqual(other.Placeholders, []string{"token"}) {
+		t.Errorf("expected the token to be left as a placeholder, got %v", other.Placeholders)
+	}
+}
+
+func TestGenerate_UnwrapsMCPRemoteBridge(t *testing.T) {
+	server := models.Server{
+		Transport: "streamable-http",
+		Config: map[string]interface{}{
+			"atlassian": map[string]interface{}{
+				"command": "npx",
+				"args":    []interface{}{"-y", "mcp-remote", "https://mcp.atlassian.com/v1/mcp"},
+			},
+		},
+	}
+
+	if got := generate(t, ClaudeCode, server).Config.MCPServers["atlassian"]; got.Type != "http" || got.URL != "https://mcp.atlassian.com/v1/mcp" {
+		t.Errorf("expected a native http entry, got %+v", got)
+	}
+	if got := generate(t, ClaudeDesktop, server).Config.MCPServers["atlassian"]; got.Command != "npx" || got.URL != "" {
+		t.Errorf("expected Claude Desktop to keep the bridge, got %+v", got)
+	}
+}
+
+func TestGenerate_LocalServer(t *testing.T) {
+	server := models.Server{
+		Transport: "stdio",
+		Config: map[string]interface{}{
+			"files": map[string]interface{}{
+				"command": "npx",
+				"args":    []interface{}{"-y", "@modelcontextprotocol/server-filesystem", "/srv"},
+				"env":     map[string]interface{}{"LOG_LEVEL": "debug"},
+			},
+		},
+	}
+
+	want := Entry{Command: "npx", Args: []string{"-y", "@modelcontextprotocol/server-filesystem", "/srv"}, Env: map[string]string{"LOG_LEVEL": "debug"}}
+	if got := generate(t, Windsurf, server).Config.MCPServers["files"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	want.Type = "stdio"
+	if got := generate(t, ClaudeCode, server).Config.MCPServers["files"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestMerge_QualifiesCollidingNames(t *testing.T) {
+	first := remoteServer
+	first.ID, first.Slug = "a1", "idp"
+	second := models.Server{
+		ID:     "b2",
+		Slug:   "wiki",
+		Inputs: []models.Input{{Name: "token", Secret: true}, {Name: "space"}},
+		Config: map[string]interface{}{
+			"idp": map[string]interface{}{
+				"command": "npx",
+				"args":    []interface{}{"wiki-mcp", "--space", "${input:space}"},
+				"env":     map[string]interface{}{"WIKI_TOKEN": "${input:token}"},
+			},
+		},
+	}
+
+	host, _ := FindHost(VSCode)
+	doc, renames, err := Merge(host, []models.Server{first, second})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(doc.Config.Servers) != 2 {
+		t.Fatalf("expected 2 entries, got %+v", doc.Config.Servers)
+	}
+	if got := doc.Config.Servers["idp-idp"].Headers["Authorization"]; got != "Bearer ${input:idp.token}" {
+		t.Errorf("expected the first server's token to be qualified, got %q", got)
+	}
+	wiki := doc.Config.Servers["idp-wiki"]
+	if wiki.Env["WIKI_TOKEN"] != "${input:wiki.token}" || wiki.Args[2] != "${input:space}" {
+		t.Errorf("expected only the shared input to be qualified, got %+v", wiki)
+	}
+
+	var ids []string
+	for _, input := range doc.Config.Inputs {
+		ids = append(ids, input.ID)
+	}
+	if want := []string{"idp.token", "wiki.token", "space"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("expected inputs %v, got %v", want, ids)
+	}
+	if !doc.Config.Inputs[0].Password {
+		t.Error("expected a renamed input to keep its declaration")
+	}
+
+	if len(renames) != 4 {
+		t.Errorf("expected 4 renames, got %+v", renames)
+	}
+}
@@ -0,0 +1,121 @@
+package clientconfig
+
+import (
+	"fmt"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// Rename records a server entry or input that was renamed because another
+// server in the same merged config uses the same name
+type Rename struct {
+	Server string `json:"server"`
+	Kind   string `json:"kind"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+// Kinds of renamed names
+const (
+	RenamedEntry = "entry"
+	RenamedInput = "input"
+)
+
+// Merge renders several servers into a single configuration for a host.
+// Entry names and input names used by more than one server are qualified
+// with the server's slug, since two servers asking for "token" almost never
+// mean the same value; every rename is listed in the document.
+func Merge(host Host, servers []models.Server) (Document, []Rename, error) {
+	entryUsers := map[string]int{}
+	inputUsers := map[string]int{}
+	for _, server := range servers {
+		for name := range server.Config {
+			entryUsers[name]++
+		}
+		for _, name := range referencedInputs(server) {
+			inputUsers[name]++
+		}
+	}
+
+	merged := Document{Host: host}
+	entries := map[string]Entry{}
+	var renames []Rename
+
+	for _, server := range servers {
+		inputNames := map[string]string{}
+		for _, name := range referencedInputs(server) {
+			if inputUsers[name] > 1 {
+				inputNames[name] = server.Slug + "." + name
+				renames = append(renames, Rename{Server: server.ID, Kind: RenamedInput, From: name, To: inputNames[name]})
+			}
+		}
+		server = renameInputs(server, inputNames)
+
+		doc, err := Generate(host, server)
+		if err != nil {
+			return Document{}, nil, fmt.Errorf("server %q: %w", server.Slug, err)
+		}
+
+		own := doc.Config.MCPServers
+		if host.ID == VSCode {
+			own = doc.Config.Servers
+		}
+		for _, name := range sortedNames(own) {
+			key := name
+			if entryUsers[name] > 1 {
+				key = uniqueKey(entries, name+"-"+server.Slug)
+				renames = append(renames, Rename{Server: server.ID, Kind: RenamedEntry, From: name, To: key})
+			}
+			entries[key] = own[name]
+		}
+
+		merged.Config.Inputs = append(merged.Config.Inputs, doc.Config.Inputs...)
+		merged.Placeholders = append(merged.Placeholders, doc.Placeholders...)
+	}
+
+	if host.ID == VSCode {
+		merged.Config.Servers = entries
+	} else {
+		merged.Config.MCPServers = entries
+	}
+
+	return merged, renames, nil
+}
+
+// renameInputs returns a copy of the server whose config references, and
+// declarations, use the new input names
+func renameInputs(server models.Server, names map[string]string) models.Server {
+	if len(names) == 0 {
+		return server
+	}
+
+	config, _ := models.ReplaceInputPlaceholders(server.Config, func(name string) string {
+		if renamed, ok := names[name]; ok {
+			return models.InputPlaceholder(renamed)
+		}
+		return models.InputPlaceholder(name)
+	}).(map[string]interface{})
+	server.Config = config
+
+	inputs := make([]models.Input, len(server.Inputs))
+	for i, input := range server.Inputs {
+		if renamed, ok := names[input.Name]; ok {
+			input.Name = renamed
+		}
+		inputs[i] = input
+	}
+	server.Inputs = inputs
+
+	return server
+}
+
+// uniqueKey appends a counter to key until no entry uses it
+func uniqueKey(entries map[string]Entry, key string) string {
+	candidate := key
+	for i := 2; ; i++ {
+		if _, taken := entries[candidate]; !taken {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s-%d", key, i)
+	}
+}
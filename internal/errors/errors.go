@@ -19,6 +19,7 @@ const (
 	ErrorTypeDatabase       ErrorType = "database"
 	ErrorTypeInternal       ErrorType = "internal"
 	ErrorTypeBadRequest     ErrorType = "bad_request"
+	ErrorTypeConflict       ErrorType = "conflict"
 )
 
 // AppError represents an application error with context
@@ -62,6 +63,8 @@ func NewAppError(errorType ErrorType, message string, cause error) *AppError {
 		err.StatusCode = http.StatusForbidden
 	case ErrorTypeNotFound:
 		err.StatusCode = http.StatusNotFound
+	case ErrorTypeConflict:
+		err.StatusCode = http.StatusConflict
 	case ErrorTypeDatabase, ErrorTypeInternal:
 		err.StatusCode = http.StatusInternalServerError
 	default:
@@ -99,40 +102,41 @@ type ErrorResponse struct {
 
 // WriteError writes an error response to the HTTP writer
 func WriteError(w http.ResponseWriter, err error) {
-	var statusCode int
-	var response ErrorResponse
+	statusCode, response := ToResponse(err)
 
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Printf("Failed to encode error response: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// ToResponse converts an error into its status code and response body, for
+// handlers that report errors inside a larger response
+func ToResponse(err error) (int, ErrorResponse) {
 	// Check if it's our custom AppError
 	if ae, ok := err.(*AppError); ok {
-		statusCode = ae.StatusCode
-		response = ErrorResponse{
-			Error:   ae.Message,
-			Type:    ae.Type,
-			Message: ae.UserMessage,
-			Details: ae.Details,
-		}
-
 		// Log internal errors with stack trace
 		if ae.Type == ErrorTypeInternal || ae.Type == ErrorTypeDatabase {
 			log.Printf("Internal error: %s\nStack: %s", ae.Error(), ae.Stack)
 		}
-	} else {
-		// Handle standard errors
-		statusCode = http.StatusInternalServerError
-		response = ErrorResponse{
-			Error:   "Internal server error",
-			Type:    ErrorTypeInternal,
-			Message: "An unexpected error occurred",
+
+		return ae.StatusCode, ErrorResponse{
+			Error:   ae.Message,
+			Type:    ae.Type,
+			Message: ae.UserMessage,
+			Details: ae.Details,
 		}
-		log.Printf("Unhandled error: %v", err)
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		log.Printf("Failed to encode error response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	// Handle standard errors
+	log.Printf("Unhandled error: %v", err)
+	return http.StatusInternalServerError, ErrorResponse{
+		Error:   "Internal server error",
+		Type:    ErrorTypeInternal,
+		Message: "An unexpected error occurred",
 	}
 }
 
@@ -180,3 +184,9 @@ func NewBadRequestError(message string) *AppError {
 	return NewAppError(ErrorTypeBadRequest, message, nil).
 		SetUserMessage("Invalid request")
 }
+
+// NewConflictError creates a conflict error
+func NewConflictError(message string) *AppError {
+	return NewAppError(ErrorTypeConflict, message, nil).
+		SetUserMessage("The request conflicts with the current state of the resource")
+}
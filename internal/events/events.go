@@ -0,0 +1,150 @@
+// Package events fans catalog changes out to live subscribers and keeps the
+// most recent ones, so that a subscriber that lost its connection can resume
+// where it left off.
+package events
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// Event types
+const (
+	ServerCreated       = "server.created"
+	ServerUpdated       = "server.updated"
+	ServerStatusChanged = "server.status_changed"
+	ServerDeleted       = "server.deleted"
+)
+
+// Types lists every event type
+var Types = []string{ServerCreated, ServerUpdated, ServerStatusChanged, ServerDeleted}
+
+// subscriberBuffer is how many events a subscriber may fall behind before it
+// is dropped. A dropped subscriber resumes from the replay buffer.
+const subscriberBuffer = 64
+
+// Event is a single change to the catalog. IDs increase by one per event and
+// are prefixed with the broker's epoch, so that an ID from before a restart
+// is recognised as unknown rather than mistaken for a recent one.
+type Event struct {
+	ID   string       `json:"id"`
+	Type string       `json:"type"`
+	Time time.Time    `json:"time"`
+	Data ServerChange `json:"data"`
+}
+
+// ServerChange is the payload of a server event. Server is the record as
+// stored, and is absent once the server is deleted. PreviousStatus is set on
+// status changes and deletions.
+type ServerChange struct {
+	ID             string         `json:"id"`
+	Slug           string         `json:"slug"`
+	Status         string         `json:"status,omitempty"`
+	PreviousStatus string         `json:"previousStatus,omitempty"`
+	Server         *models.Server `json:"server,omitempty"`
+}
+
+// Broker publishes events to subscribers and keeps the last ones for replay
+type Broker struct {
+	mu          sync.Mutex
+	epoch       string
+	seq         uint64
+	replay      []Event
+	replaySize  int
+	subscribers map[chan Event]struct{}
+}
+
+// NewBroker creates a broker that keeps the last replaySize events
+func NewBroker(replaySize int) *Broker {
+	return &Broker{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		replaySize:  replaySize,
+		subscribers: map[chan Event]struct{}{},
+	}
+}
+
+// Publish assigns the next ID to an event and hands it to every subscriber
+func (b *Broker) Publish(eventType string, change ServerChange) Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event := Event{
+		ID:   fmt.Sprintf("%s-%d", b.epoch, b.seq),
+		Type: eventType,
+		Time: time.Now().UTC(),
+		Data: change,
+	}
+
+	b.replay = append(b.replay, event)
+	if len(b.replay) > b.replaySize {
+		b.replay = b.replay[len(b.replay)-b.replaySize:]
+	}
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			// Too far behind to keep up; closing the channel ends its
+			// stream and the subscriber resumes from the replay buffer
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return event
+}
+
+// Subscribe starts delivering events published from now on. When lastEventID
+// is given, the events published after it are returned for replay first;
+// complete is false when that ID is no longer, or never was, in the buffer,
+// in which case the subscriber has missed events and should resynchronise.
+// The channel is closed when the subscriber falls too far behind, and
+// cancel must be called once the subscriber is done.
+func (b *Broker) Subscribe(lastEventID string) (replay []Event, events <-chan Event, cancel func(), complete bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	complete = true
+	if lastEventID != "" {
+		replay, complete = b.since(lastEventID)
+	}
+
+	ch := make(chan Event, subscriberBuffer)
+	b.subscribers[ch] = struct{}{}
+
+	cancel = func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[ch]; ok {
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return replay, ch, cancel, complete
+}
+
+// since returns the buffered events after the one with the given ID
+func (b *Broker) since(id string) ([]Event, bool) {
+	epoch, seqText, ok := strings.Cut(id, "-")
+	seq, err := strconv.ParseUint(seqText, 10, 64)
+	if !ok || err != nil || epoch != b.epoch || seq > b.seq {
+		return nil, false
+	}
+	if seq == b.seq {
+		return nil, true
+	}
+
+	// The buffer holds consecutive sequence numbers ending at b.seq
+	oldest := b.seq - uint64(len(b.replay)) + 1
+	if seq+1 < oldest {
+		return nil, false
+	}
+	return append([]Event(nil), b.replay[seq+1-oldest:]...), true
+}
@@ -0,0 +1,59 @@
+package events
+
+import (
+	"fmt"
+	"testing"
+)
+
+func publish(b *Broker, n int) []Event {
+	var published []Event
+	for i := 0; i < n; i++ {
+		published = append(published, b.Publish(ServerUpdated, ServerChange{ID: fmt.Sprint(i)}))
+	}
+	return published
+}
+
+func TestBroker_ReplaysAfterLastEventID(t *testing.T) {
+	b := NewBroker(3)
+	published := publish(b, 5)
+
+	replay, _, cancel, complete := b.Subscribe(published[2].ID)
+	defer cancel()
+	if !complete || len(replay) != 2 || replay[0].ID != published[3].ID || replay[1].ID != published[4].ID {
+		t.Errorf("expected the last two events, got %+v (complete %v)", replay, complete)
+	}
+
+	if replay, _, cancel, complete := b.Subscribe(published[4].ID); !complete || len(replay) != 0 {
+		t.Errorf("expected nothing to replay for the latest ID, got %+v", replay)
+	} else {
+		cancel()
+	}
+
+	for _, id := range []string{published[0].ID, "unknown", "0-1", published[4].ID + "0"} {
+		if _, _, cancel, complete := b.Subscribe(id); complete {
+			t.Errorf("expected %q to be reported as missed", id)
+		} else {
+			cancel()
+		}
+	}
+}
+
+func TestBroker_DeliversAndDropsSlowSubscribers(t *testing.T) {
+	b := NewBroker(10)
+
+	_, events, cancel, _ := b.Subscribe("")
+	defer cancel()
+	event := b.Publish(ServerDeleted, ServerChange{ID: "a"})
+	if got := <-events; got.ID != event.ID || got.Type != ServerDeleted {
+		t.Fatalf("expected the published event, got %+v", got)
+	}
+
+	publish(b, subscriberBuffer+1)
+	received := 0
+	for range events {
+		received++
+	}
+	if received != subscriberBuffer {
+		t.Errorf("expected the channel to close after %d events, got %d", subscriberBuffer, received)
+	}
+}
@@ -0,0 +1,305 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: registry/v1/registry.proto
+
+// The server catalog of the MCP registry, served over gRPC and Connect on
+// the same port as the HTTP API. Messages mirror the JSON records of the
+// REST API; field names are their snake_case forms.
+package registryv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/bear-belly/mcp-registry/internal/gen/registry/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ServerServiceName is the fully-qualified name of the ServerService service.
+	ServerServiceName = "registry.v1.ServerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ServerServiceListServersProcedure is the fully-qualified name of the ServerService's ListServers
+	// RPC.
+	ServerServiceListServersProcedure = "/registry.v1.ServerService/ListServers"
+	// ServerServiceGetServerProcedure is the fully-qualified name of the ServerService's GetServer RPC.
+	ServerServiceGetServerProcedure = "/registry.v1.ServerService/GetServer"
+	// ServerServiceWatchServersProcedure is the fully-qualified name of the ServerService's
+	// WatchServers RPC.
+	ServerServiceWatchServersProcedure = "/registry.v1.ServerService/WatchServers"
+	// ServerServiceCreateServerProcedure is the fully-qualified name of the ServerService's
+	// CreateServer RPC.
+	ServerServiceCreateServerProcedure = "/registry.v1.ServerService/CreateServer"
+	// ServerServiceUpdateServerProcedure is the fully-qualified name of the ServerService's
+	// UpdateServer RPC.
+	ServerServiceUpdateServerProcedure = "/registry.v1.ServerService/UpdateServer"
+	// ServerServiceChangeServerStatusProcedure is the fully-qualified name of the ServerService's
+	// ChangeServerStatus RPC.
+	ServerServiceChangeServerStatusProcedure = "/registry.v1.ServerService/ChangeServerStatus"
+	// ServerServiceDeleteServerProcedure is the fully-qualified name of the ServerService's
+	// DeleteServer RPC.
+	ServerServiceDeleteServerProcedure = "/registry.v1.ServerService/DeleteServer"
+)
+
+// ServerServiceClient is a client for the registry.v1.ServerService service.
+type ServerServiceClient interface {
+	// ListServers returns a page of servers matching every filter given.
+	// Repeated filters match any of their values.
+	ListServers(context.Context, *connect.Request[v1.ListServersRequest]) (*connect.Response[v1.ListServersResponse], error)
+	// GetServer returns a server by ID or slug. Previous slugs also match.
+	GetServer(context.Context, *connect.Request[v1.GetServerRequest]) (*connect.Response[v1.GetServerResponse], error)
+	// WatchServers streams catalog changes as they happen. A client that
+	// reconnects with the ID of the last event it received first gets the
+	// events it missed.
+	WatchServers(context.Context, *connect.Request[v1.WatchServersRequest]) (*connect.ServerStreamForClient[v1.WatchServersResponse], error)
+	CreateServer(context.Context, *connect.Request[v1.CreateServerRequest]) (*connect.Response[v1.CreateServerResponse], error)
+	// UpdateServer replaces a server, as PUT does in the REST API
+	UpdateServer(context.Context, *connect.Request[v1.UpdateServerRequest]) (*connect.Response[v1.UpdateServerResponse], error)
+	// ChangeServerStatus moves a server through the approval workflow
+	ChangeServerStatus(context.Context, *connect.Request[v1.ChangeServerStatusRequest]) (*connect.Response[v1.ChangeServerStatusResponse], error)
+	DeleteServer(context.Context, *connect.Request[v1.DeleteServerRequest]) (*connect.Response[v1.DeleteServerResponse], error)
+}
+
+// NewServerServiceClient constructs a client for the registry.v1.ServerService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewServerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	serverServiceMethods := v1.File_registry_v1_registry_proto.Services().ByName("ServerService").Methods()
+	return &serverServiceClient{
+		listServers: connect.NewClient[v1.ListServersRequest, v1.ListServersResponse](
+			httpClient,
+			baseURL+ServerServiceListServersProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("ListServers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getServer: connect.NewClient[v1.GetServerRequest, v1.GetServerResponse](
+			httpClient,
+			baseURL+ServerServiceGetServerProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("GetServer")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		watchServers: connect.NewClient[v1.WatchServersRequest, v1.WatchServersResponse](
+			httpClient,
+			baseURL+ServerServiceWatchServersProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("WatchServers")),
+			connect.WithClientOptions(opts...),
+		),
+		createServer: connect.NewClient[v1.CreateServerRequest, v1.CreateServerResponse](
+			httpClient,
+			baseURL+ServerServiceCreateServerProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("CreateServer")),
+			connect.WithClientOptions(opts...),
+		),
+		updateServer: connect.NewClient[v1.UpdateServerRequest, v1.UpdateServerResponse](
+			httpClient,
+			baseURL+ServerServiceUpdateServerProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("UpdateServer")),
+			connect.WithClientOptions(opts...),
+		),
+		changeServerStatus: connect.NewClient[v1.ChangeServerStatusRequest, v1.ChangeServerStatusResponse](
+			httpClient,
+			baseURL+ServerServiceChangeServerStatusProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("ChangeServerStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteServer: connect.NewClient[v1.DeleteServerRequest, v1.DeleteServerResponse](
+			httpClient,
+			baseURL+ServerServiceDeleteServerProcedure,
+			connect.WithSchema(serverServiceMethods.ByName("DeleteServer")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// serverServiceClient implements ServerServiceClient.
+type serverServiceClient struct {
+	listServers        *connect.Client[v1.ListServersRequest, v1.ListServersResponse]
+	getServer          *connect.Client[v1.GetServerRequest, v1.GetServerResponse]
+	watchServers       *connect.Client[v1.WatchServersRequest, v1.WatchServersResponse]
+	createServer       *connect.Client[v1.CreateServerRequest, v1.CreateServerResponse]
+	updateServer       *connect.Client[v1.UpdateServerRequest, v1.UpdateServerResponse]
+	changeServerStatus *connect.Client[v1.ChangeServerStatusRequest, v1.ChangeServerStatusResponse]
+	deleteServer       *connect.Client[v1.DeleteServerRequest, v1.DeleteServerResponse]
+}
+
+// ListServers calls registry.v1.ServerService.ListServers.
+func (c *serverServiceClient) ListServers(ctx context.Context, req *connect.Request[v1.ListServersRequest]) (*connect.Response[v1.ListServersResponse], error) {
+	return c.listServers.CallUnary(ctx, req)
+}
+
+// GetServer calls registry.v1.ServerService.GetServer.
+func (c *serverServiceClient) GetServer(ctx context.Context, req *connect.Request[v1.GetServerRequest]) (*connect.Response[v1.GetServerResponse], error) {
+	return c.getServer.CallUnary(ctx, req)
+}
+
+// WatchServers calls registry.v1.ServerService.WatchServers.
+func (c *serverServiceClient) WatchServers(ctx context.Context, req *connect.Request[v1.WatchServersRequest]) (*connect.ServerStreamForClient[v1.WatchServersResponse], error) {
+	return c.watchServers.CallServerStream(ctx, req)
+}
+
+// CreateServer calls registry.v1.ServerService.CreateServer.
+func (c *serverServiceClient) CreateServer(ctx context.Context, req *connect.Request[v1.CreateServerRequest]) (*connect.Response[v1.CreateServerResponse], error) {
+	return c.createServer.CallUnary(ctx, req)
+}
+
+// UpdateServer calls registry.v1.ServerService.UpdateServer.
+func (c *serverServiceClient) UpdateServer(ctx context.Context, req *connect.Request[v1.UpdateServerRequest]) (*connect.Response[v1.UpdateServerResponse], error) {
+	return c.updateServer.CallUnary(ctx, req)
+}
+
+// ChangeServerStatus calls registry.v1.ServerService.ChangeServerStatus.
+func (c *serverServiceClient) ChangeServerStatus(ctx context.Context, req *connect.Request[v1.ChangeServerStatusRequest]) (*connect.Response[v1.ChangeServerStatusResponse], error) {
+	return c.changeServerStatus.CallUnary(ctx, req)
+}
+
+// DeleteServer calls registry.v1.ServerService.DeleteServer.
+func (c *serverServiceClient) DeleteServer(ctx context.Context, req *connect.Request[v1.DeleteServerRequest]) (*connect.Response[v1.DeleteServerResponse], error) {
+	return c.deleteServer.CallUnary(ctx, req)
+}
+
+// ServerServiceHandler is an implementation of the registry.v1.ServerService service.
+type ServerServiceHandler interface {
+	// ListServers returns a page of servers matching every filter given.
+	// Repeated filters match any of their values.
+	ListServers(context.Context, *connect.Request[v1.ListServersRequest]) (*connect.Response[v1.ListServersResponse], error)
+	// GetServer returns a server by ID or slug. Previous slugs also match.
+	GetServer(context.Context, *connect.Request[v1.GetServerRequest]) (*connect.Response[v1.GetServerResponse], error)
+	// WatchServers streams catalog changes as they happen. A client that
+	// reconnects with the ID of the last event it received first gets the
+	// events it missed.
+	WatchServers(context.Context, *connect.Request[v1.WatchServersRequest], *connect.ServerStream[v1.WatchServersResponse]) error
+	CreateServer(context.Context, *connect.Request[v1.CreateServerRequest]) (*connect.Response[v1.CreateServerResponse], error)
+	// UpdateServer replaces a server, as PUT does in the REST API
+	UpdateServer(context.Context, *connect.Request[v1.UpdateServerRequest]) (*connect.Response[v1.UpdateServerResponse], error)
+	// ChangeServerStatus moves a server through the approval workflow
+	ChangeServerStatus(context.Context, *connect.Request[v1.ChangeServerStatusRequest]) (*connect.Response[v1.ChangeServerStatusResponse], error)
+	DeleteServer(context.Context, *connect.Request[v1.DeleteServerRequest]) (*connect.Response[v1.DeleteServerResponse], error)
+}
+
+// NewServerServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewServerServiceHandler(svc ServerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serverServiceMethods := v1.File_registry_v1_registry_proto.Services().ByName("ServerService").Methods()
+	serverServiceListServersHandler := connect.NewUnaryHandler(
+		ServerServiceListServersProcedure,
+		svc.ListServers,
+		connect.WithSchema(serverServiceMethods.ByName("ListServers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceGetServerHandler := connect.NewUnaryHandler(
+		ServerServiceGetServerProcedure,
+		svc.GetServer,
+		connect.WithSchema(serverServiceMethods.ByName("GetServer")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceWatchServersHandler := connect.NewServerStreamHandler(
+		ServerServiceWatchServersProcedure,
+		svc.WatchServers,
+		connect.WithSchema(serverServiceMethods.ByName("WatchServers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceCreateServerHandler := connect.NewUnaryHandler(
+		ServerServiceCreateServerProcedure,
+		svc.CreateServer,
+		connect.WithSchema(serverServiceMethods.ByName("CreateServer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceUpdateServerHandler := connect.NewUnaryHandler(
+		ServerServiceUpdateServerProcedure,
+		svc.UpdateServer,
+		connect.WithSchema(serverServiceMethods.ByName("UpdateServer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceChangeServerStatusHandler := connect.NewUnaryHandler(
+		ServerServiceChangeServerStatusProcedure,
+		svc.ChangeServerStatus,
+		connect.WithSchema(serverServiceMethods.ByName("ChangeServerStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverServiceDeleteServerHandler := connect.NewUnaryHandler(
+		ServerServiceDeleteServerProcedure,
+		svc.DeleteServer,
+		connect.WithSchema(serverServiceMethods.ByName("DeleteServer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/registry.v1.ServerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ServerServiceListServersProcedure:
+			serverServiceListServersHandler.ServeHTTP(w, r)
+		case ServerServiceGetServerProcedure:
+			serverServiceGetServerHandler.ServeHTTP(w, r)
+		case ServerServiceWatchServersProcedure:
+			serverServiceWatchServersHandler.ServeHTTP(w, r)
+		case ServerServiceCreateServerProcedure:
+			serverServiceCreateServerHandler.ServeHTTP(w, r)
+		case ServerServiceUpdateServerProcedure:
+			serverServiceUpdateServerHandler.ServeHTTP(w, r)
+		case ServerServiceChangeServerStatusProcedure:
+			serverServiceChangeServerStatusHandler.ServeHTTP(w, r)
+		case ServerServiceDeleteServerProcedure:
+			serverServiceDeleteServerHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedServerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedServerServiceHandler struct{}
+
+func (UnimplementedServerServiceHandler) ListServers(context.Context, *connect.Request[v1.ListServersRequest]) (*connect.Response[v1.ListServersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("registry.v1.ServerService.ListServers is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) GetServer(context.Context, *connect.Request[v1.GetServerRequest]) (*connect.Response[v1.GetServerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("registry.v1.ServerService.GetServer is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) WatchServers(context.Context, *connect.Request[v1.WatchServersRequest], *connect.ServerStream[v1.WatchServersResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("registry.v1.ServerService.WatchServers is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) CreateServer(context.Context, *connect.Request[v1.CreateServerRequest]) (*connect.Response[v1.CreateServerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("registry.v1.ServerService.CreateServer is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) UpdateServer(context.Context, *connect.Request[v1.UpdateServerRequest]) (*connect.Response[v1.UpdateServerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("registry.v1.ServerService.UpdateServer is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) ChangeServerStatus(context.Context, *connect.Request[v1.ChangeServerStatusRequest]) (*connect.Response[v1.ChangeServerStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("registry.v1.ServerService.ChangeServerStatus is not implemented"))
+}
+
+func (UnimplementedServerServiceHandler) DeleteServer(context.Context, *connect.Request[v1.DeleteServerRequest]) (*connect.Response[v1.DeleteServerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("registry.v1.ServerService.DeleteServer is not implemented"))
+}
@@ -0,0 +1,270 @@
+package license
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Expression operators
+const (
+	OpAnd = "AND"
+	OpOr  = "OR"
+)
+
+// Expression is a parsed SPDX license expression. A leaf names a single
+// license, optionally with an exception; any other node combines its
+// operands with AND or OR.
+type Expression struct {
+	Op       string
+	Operands []*Expression
+
+	License   string // canonical identifier, e.g. GPL-2.0-only or LicenseRef-acme
+	OrLater   bool   // the identifier was followed by +
+	Exception string // canonical identifier of the WITH exception
+}
+
+// Parse parses an SPDX license expression such as "MIT OR Apache-2.0" or
+// "GPL-2.0-only WITH Classpath-exception-2.0". Every identifier must be on
+// the bundled SPDX list and not deprecated, except LicenseRef- identifiers
+// which name licenses outside the list.
+func Parse(expression string) (*Expression, error) {
+	p := &parser{tokens: tokenize(expression)}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("license expression is empty")
+	}
+
+	parsed, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token, ok := p.peek(); ok {
+		return nil, fmt.Errorf("unexpected %q in license expression", token)
+	}
+
+	return parsed, nil
+}
+
+// String formats the expression with canonical identifiers, adding
+// parentheses only where precedence requires them
+func (e *Expression) String() string {
+	if e.Op == "" {
+		s := e.License
+		if e.OrLater {
+			s += "+"
+		}
+		if e.Exception != "" {
+			s += " WITH " + e.Exception
+		}
+		return s
+	}
+
+	parts := make([]string, len(e.Operands))
+	for i, operand := range e.Operands {
+		parts[i] = operand.String()
+		// AND binds tighter than OR
+		if e.Op == OpAnd && operand.Op == OpOr {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, " "+e.Op+" ")
+}
+
+// Licenses returns the license identifiers the expression mentions
+func (e *Expression) Licenses() []string {
+	if e.Op == "" {
+		return []string{e.License}
+	}
+
+	var ids []string
+	for _, operand := range e.Operands {
+		ids = append(ids, operand.Licenses()...)
+	}
+	return ids
+}
+
+// tokenize splits an expression into parentheses and words. A trailing + on
+// a word is kept as a token of its own.
+func tokenize(expression string) []string {
+	var tokens []string
+	word := strings.Builder{}
+	flush := func() {
+		if word.Len() == 0 {
+			return
+		}
+		w := word.String()
+		if len(w) > 1 && strings.HasSuffix(w, "+") {
+			tokens = append(tokens, strings.TrimSuffix(w, "+"), "+")
+		} else {
+			tokens = append(tokens, w)
+		}
+		word.Reset()
+	}
+
+	for _, r := range expression {
+		switch {
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			flush()
+		default:
+			word.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() (string, bool) {
+	if p.pos >= len(p.tokens) {
+		return "", false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (string, bool) {
+	token, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return token, ok
+}
+
+// acceptOperator consumes the next token if it is the given operator.
+// Operators are matched case-insensitively.
+func (p *parser) acceptOperator(op string) bool {
+	if token, ok := p.peek(); ok && strings.EqualFold(token, op) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// parseOr := parseAnd { OR parseAnd }
+func (p *parser) parseOr() (*Expression, error) {
+	return p.parseBinary(OpOr, p.parseAnd)
+}
+
+// parseAnd := parseWith { AND parseWith }
+func (p *parser) parseAnd() (*Expression, error) {
+	return p.parseBinary(OpAnd, p.parseWith)
+}
+
+func (p *parser) parseBinary(op string, operand func() (*Expression, error)) (*Expression, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	operands := []*Expression{first}
+	for p.acceptOperator(op) {
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, next)
+	}
+
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return &Expression{Op: op, Operands: operands}, nil
+}
+
+// parseWith := "(" parseOr ")" | license [ "+" ] [ WITH exception ]
+func (p *parser) parseWith() (*Expression, error) {
+	token, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("license expression ends unexpectedly")
+	}
+
+	if token == "(" {
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing, _ := p.next(); closing != ")" {
+			return nil, fmt.Errorf("license expression is missing a closing parenthesis")
+		}
+		return inner, nil
+	}
+
+	if isKeyword(token) {
+		return nil, fmt.Errorf("expected a license identifier, got %q", token)
+	}
+
+	id, err := licenseID(token)
+	if err != nil {
+		return nil, err
+	}
+	leaf := &Expression{License: id}
+
+	if next, _ := p.peek(); next == "+" {
+		p.pos++
+		leaf.OrLater = true
+	}
+
+	if p.acceptOperator("WITH") {
+		token, ok := p.next()
+		if !ok || isKeyword(token) {
+			return nil, fmt.Errorf("expected a license exception after WITH")
+		}
+		if leaf.Exception, err = exceptionID(token); err != nil {
+			return nil, err
+		}
+	}
+
+	return leaf, nil
+}
+
+func isKeyword(token string) bool {
+	switch strings.ToUpper(token) {
+	case OpAnd, OpOr, "WITH", "(", ")", "+":
+		return true
+	}
+	return false
+}
+
+// licenseID resolves a license identifier to its canonical spelling
+func licenseID(token string) (string, error) {
+	if isLicenseRef(token) {
+		return token, nil
+	}
+
+	entry, ok := licenses[strings.ToLower(token)]
+	if !ok {
+		return "", fmt.Errorf("%q is not an SPDX license identifier", token)
+	}
+	if entry.Deprecated {
+		return "", fmt.Errorf("%q is deprecated in the SPDX license list", entry.ID)
+	}
+	return entry.ID, nil
+}
+
+// exceptionID resolves a license exception identifier to its canonical
+// spelling
+func exceptionID(token string) (string, error) {
+	entry, ok := exceptions[strings.ToLower(token)]
+	if !ok {
+		return "", fmt.Errorf("%q is not an SPDX license exception identifier", token)
+	}
+	if entry.Deprecated {
+		return "", fmt.Errorf("%q is deprecated in the SPDX exception list", entry.ID)
+	}
+	return entry.ID, nil
+}
+
+// isLicenseRef reports whether the token names a license outside the SPDX
+// list, either LicenseRef-x or DocumentRef-y:LicenseRef-x
+func isLicenseRef(token string) bool {
+	if _, ref, found := strings.Cut(token, ":"); found {
+		return strings.HasPrefix(token, "DocumentRef-") && isLicenseRef(ref)
+	}
+	return strings.HasPrefix(token, "LicenseRef-") && len(token) > len("LicenseRef-")
+}
@@ -0,0 +1,94 @@
+package license
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func TestParse_CanonicalForm(t *testing.T) {
+	tests := map[string]string{
+		"MIT":                                       "MIT",
+		"mit or apache-2.0":                         "MIT OR Apache-2.0",
+		"(MIT OR Apache-2.0) AND BSD-3-Clause":      "(MIT OR Apache-2.0) AND BSD-3-Clause",
+		"MIT OR Apache-2.0 AND BSD-3-Clause":        "MIT OR Apache-2.0 AND BSD-3-Clause",
+		"GPL-2.0-only WITH Classpath-exception-2.0": "GPL-2.0-only WITH Classpath-exception-2.0",
+		"MPL-1.1+":                                  "MPL-1.1+",
+		"LicenseRef-acme-internal":                  "LicenseRef-acme-internal",
+		"DocumentRef-spdx:LicenseRef-acme AND ISC":  "DocumentRef-spdx:LicenseRef-acme AND ISC",
+		"((MIT))": "MIT",
+	}
+
+	for input, want := range tests {
+		expression, err := Parse(input)
+		if err != nil {
+			t.Errorf("Parse(%q): unexpected error %v", input, err)
+			continue
+		}
+		if got := expression.String(); got != want {
+			t.Errorf("Parse(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestParse_Rejects(t *testing.T) {
+	tests := map[string]string{
+		"":                   "empty",
+		"Not-A-License":      "not an SPDX license identifier",
+		"GPL-2.0":            "deprecated",
+		"MIT OR":             "ends unexpectedly",
+		"MIT AND AND ISC":    "expected a license identifier",
+		"(MIT OR ISC":        "closing parenthesis",
+		"MIT ISC":            "unexpected",
+		"MIT WITH Bogus-1.0": "not an SPDX license exception",
+		"LicenseRef-":        "not an SPDX license identifier",
+	}
+
+	for input, want := range tests {
+		_, err := Parse(input)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Parse(%q): expected error containing %q, got %v", input, want, err)
+		}
+	}
+}
+
+func TestEvaluate_DefaultPolicy(t *testing.T) {
+	tests := map[string]string{
+		"MIT":                             models.LicenseAllowed,
+		"GPL-3.0-only":                    models.LicenseNeedsReview,
+		"AGPL-3.0-or-later":               models.LicenseNeedsReview,
+		"SSPL-1.0":                        models.LicenseForbidden,
+		"LicenseRef-acme":                 models.LicenseNeedsReview,
+		"MIT OR GPL-3.0-only":             models.LicenseAllowed,
+		"MIT AND GPL-3.0-only":            models.LicenseNeedsReview,
+		"Apache-2.0 AND SSPL-1.0":         models.LicenseForbidden,
+		"(SSPL-1.0 OR MIT) AND MPL-2.0":   models.LicenseNeedsReview,
+		"CC-BY-NC-4.0 OR CC-BY-NC-SA-4.0": models.LicenseForbidden,
+	}
+
+	policy := DefaultPolicy()
+	for input, want := range tests {
+		expression, err := Parse(input)
+		if err != nil {
+			t.Fatalf("Parse(%q): unexpected error %v", input, err)
+		}
+		if got := Evaluate(expression, policy); got != want {
+			t.Errorf("Evaluate(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestDefaultPolicy_ListsKnownLicenses(t *testing.T) {
+	policy := DefaultPolicy()
+	for _, entries := range [][]string{policy.Allowed, policy.NeedsReview, policy.Forbidden} {
+		for _, entry := range entries {
+			if strings.HasSuffix(entry, "*") {
+				continue
+			}
+			if _, err := Parse(entry); err != nil {
+				t.Errorf("default policy entry %q: %v", entry, err)
+			}
+		}
+	}
+}
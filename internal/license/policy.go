@@ -0,0 +1,106 @@
+package license
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// DefaultPolicy returns the policy used when no policy file is configured.
+// Permissive licenses are allowed, copyleft and weak-copyleft licenses need
+// legal review and source-available licenses that restrict use are
+// forbidden. Anything else needs review.
+func DefaultPolicy() models.LicensePolicy {
+	return models.LicensePolicy{
+		Allowed: []string{
+			"0BSD", "Apache-2.0", "BSD-2-Clause", "BSD-3-Clause", "BSL-1.0",
+			"CC0-1.0", "CC-BY-4.0", "ISC", "MIT", "MIT-0", "PostgreSQL",
+			"Python-2.0", "Unlicense", "X11", "Zlib",
+		},
+		NeedsReview: []string{
+			"AGPL-*", "APSL-*", "CC-BY-SA-*", "CDDL-*", "CPL-*", "EPL-*",
+			"EUPL-*", "GPL-*", "LGPL-*", "MPL-*", "MS-RL", "OSL-*",
+		},
+		Forbidden: []string{
+			"BUSL-1.1", "CC-BY-NC-*", "Elastic-2.0", "SSPL-1.0",
+		},
+		Default: models.LicenseNeedsReview,
+	}
+}
+
+// LoadPolicy reads a license policy from a JSON file. An empty path selects
+// the default policy.
+func LoadPolicy(path string) (models.LicensePolicy, error) {
+	if path == "" {
+		return DefaultPolicy(), nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return models.LicensePolicy{}, fmt.Errorf("reading license policy: %w", err)
+	}
+
+	var policy models.LicensePolicy
+	if err := json.Unmarshal(content, &policy); err != nil {
+		return models.LicensePolicy{}, fmt.Errorf("parsing license policy: %w", err)
+	}
+	if !slices.Contains(models.LicenseDecisions, policy.Default) {
+		return models.LicensePolicy{}, fmt.Errorf("license policy default must be one of %s", strings.Join(models.LicenseDecisions, ", "))
+	}
+
+	return policy, nil
+}
+
+// Evaluate decides what the policy says about a license expression. A choice
+// between licenses (OR) is as good as its best option, while licenses that
+// all apply (AND) are as bad as the worst of them.
+func Evaluate(expression *Expression, policy models.LicensePolicy) string {
+	if expression.Op == "" {
+		return decide(expression.License, policy)
+	}
+
+	decisions := make([]int, len(expression.Operands))
+	for i, operand := range expression.Operands {
+		decisions[i] = slices.Index(models.LicenseDecisions, Evaluate(operand, policy))
+	}
+
+	if expression.Op == OpOr {
+		return models.LicenseDecisions[slices.Min(decisions)]
+	}
+	return models.LicenseDecisions[slices.Max(decisions)]
+}
+
+// decide looks a single license up in the policy. The strictest matching
+// list wins, so a broad allow entry cannot mask a specific forbid.
+func decide(id string, policy models.LicensePolicy) string {
+	switch {
+	case matchesAny(id, policy.Forbidden):
+		return models.LicenseForbidden
+	case matchesAny(id, policy.NeedsReview):
+		return models.LicenseNeedsReview
+	case matchesAny(id, policy.Allowed):
+		return models.LicenseAllowed
+	}
+
+	if slices.Contains(models.LicenseDecisions, policy.Default) {
+		return policy.Default
+	}
+	return models.LicenseNeedsReview
+}
+
+func matchesAny(id string, entries []string) bool {
+	for _, entry := range entries {
+		if prefix, wildcard := strings.CutSuffix(entry, "*"); wildcard {
+			if len(id) >= len(prefix) && strings.EqualFold(id[:len(prefix)], prefix) {
+				return true
+			}
+		} else if strings.EqualFold(id, entry) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package license
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strings"
+)
+
+// spdxJSON is a snapshot of the SPDX license list: identifiers of licenses
+// and license exceptions and whether they are deprecated
+//
+//go:embed spdx.json
+var spdxJSON []byte
+
+type spdxEntry struct {
+	ID         string `json:"id"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+type spdxList struct {
+	Version    string      `json:"licenseListVersion"`
+	Licenses   []spdxEntry `json:"licenses"`
+	Exceptions []spdxEntry `json:"exceptions"`
+}
+
+// SPDX identifiers match case-insensitively, so both indexes are keyed by
+// the lower-cased identifier
+var (
+	listVersion string
+	licenses    map[string]spdxEntry
+	exceptions  map[string]spdxEntry
+)
+
+func init() {
+	var list spdxList
+	if err := json.Unmarshal(spdxJSON, &list); err != nil {
+		panic("license: parsing bundled SPDX list: " + err.Error())
+	}
+
+	listVersion = list.Version
+	licenses = index(list.Licenses)
+	exceptions = index(list.Exceptions)
+}
+
+func index(entries []spdxEntry) map[string]spdxEntry {
+	indexed := make(map[string]spdxEntry, len(entries))
+	for _, entry := range entries {
+		indexed[strings.ToLower(entry.ID)] = entry
+	}
+	return indexed
+}
+
+// ListVersion returns the version of the bundled SPDX license list
+func ListVersion() string {
+	return listVersion
+}
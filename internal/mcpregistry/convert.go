@@ -0,0 +1,290 @@
+package mcpregistry
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// UnversionedVersion is reported for servers that do not declare a version
+const UnversionedVersion = "0.0.0"
+
+// Name returns the reverse-DNS style name of a server within the namespace,
+// e.g. com.example/github
+func Name(namespace string, server models.Server) string {
+	return namespace + "/" + server.Slug
+}
+
+// FromServer maps a catalog record onto a server.json document. Config
+// entries become remotes when they point at a URL (directly or through
+// mcp-remote) and packages when they run npx, uvx or docker; other commands
+// cannot be expressed and are left out. Inputs referenced as ${input:name}
+// become {name} variables.
+func FromServer(server models.Server, namespace string, isLatest bool) ServerJSON {
+	doc := ServerJSON{
+		Schema:      SchemaURL,
+		Name:        Name(namespace, server),
+		Description: server.Description,
+		Version:     server.Version,
+		Status:      StatusActive,
+		Meta: &Meta{Official: &OfficialMeta{
+			ServerID:    server.ID,
+			PublishedAt: server.CreatedAt,
+			UpdatedAt:   server.UpdatedAt,
+			IsLatest:    isLatest,
+		}},
+	}
+	if doc.Version == "" {
+		doc.Version = UnversionedVersion
+	}
+
+	if source := repositorySource(server.URL); source != "" {
+		doc.Repository = &Repository{URL: server.URL, Source: source}
+	} else {
+		doc.WebsiteURL = server.URL
+	}
+
+	keys := make([]string, 0, len(server.Config))
+	for key := range server.Config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	c := converter{server: server}
+	for _, key := range keys {
+		entry, ok := server.Config[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if remote, ok := c.remote(entry); ok {
+			doc.Remotes = append(doc.Remotes, remote)
+		} else if pkg, ok := c.pkg(entry); ok {
+			doc.Packages = append(doc.Packages, pkg)
+		}
+	}
+
+	return doc
+}
+
+func repositorySource(address string) string {
+	parsed, err := url.Parse(address)
+	if err != nil {
+		return ""
+	}
+	switch parsed.Host {
+	case "github.com":
+		return "github"
+	case "gitlab.com":
+		return "gitlab"
+	}
+	return ""
+}
+
+type converter struct {
+	server models.Server
+}
+
+// remoteType returns the transport of remotes. The registry uses the same
+// transport names as the catalog.
+func (c converter) remoteType() string {
+	if c.server.TransportType() == models.TransportSSE {
+		return models.TransportSSE
+	}
+	return models.TransportStreamableHTTP
+}
+
+// remote maps an entry that connects to a URL, either directly or through
+// the mcp-remote bridge
+func (c converter) remote(entry map[string]interface{}) (Transport, bool) {
+	address, _ := entry["url"].(string)
+	if address == "" {
+		command, _ := entry["command"].(string)
+		positional := positionalArgs(stringList(entry["args"]))
+		if command != "npx" || len(positional) < 2 || packageName(positional[0]) != "mcp-remote" {
+			return Transport{}, false
+		}
+		address = positional[1]
+	}
+
+	transport := Transport{Type: c.remoteType()}
+	transport.URL, transport.Variables = c.template(address)
+
+	for _, name := range sortedKeys(entry["headers"]) {
+		value, _ := entry["headers"].(map[string]interface{})[name].(string)
+		transport.Headers = append(transport.Headers, c.keyValue(name, value))
+	}
+
+	return transport, true
+}
+
+// pkg maps an entry that runs a package locally
+func (c converter) pkg(entry map[string]interface{}) (Package, bool) {
+	command, _ := entry["command"].(string)
+	args := stringList(entry["args"])
+
+	var pkg Package
+	var rest []string
+	switch command {
+	case "npx":
+		positional := positionalArgs(args)
+		if len(positional) == 0 {
+			return Package{}, false
+		}
+		pkg = Package{RegistryType: "npm", RuntimeHint: "npx"}
+		pkg.Identifier, pkg.Version = splitVersion(positional[0], "@")
+		rest = positional[1:]
+	case "uvx":
+		positional := positionalArgs(args)
+		if len(positional) == 0 {
+			return Package{}, false
+		}
+		pkg = Package{RegistryType: "pypi", RuntimeHint: "uvx"}
+		pkg.Identifier, pkg.Version = splitVersion(positional[0], "==")
+		rest = positional[1:]
+	case "docker":
+		image, after, ok := dockerImage(args)
+		if !ok {
+			return Package{}, false
+		}
+		pkg = Package{RegistryType: "oci", RuntimeHint: "docker"}
+		pkg.Identifier, pkg.Version = splitVersion(image, ":")
+		rest = after
+	default:
+		return Package{}, false
+	}
+
+	pkg.Transport = Transport{Type: models.TransportStdio}
+	for _, arg := range rest {
+		argument := Argument{Type: ArgumentPositional}
+		argument.Value, argument.Variables = c.template(arg)
+		pkg.PackageArguments = append(pkg.PackageArguments, argument)
+	}
+	for _, name := range sortedKeys(entry["env"]) {
+		value, _ := entry["env"].(map[string]interface{})[name].(string)
+		pkg.EnvironmentVariables = append(pkg.EnvironmentVariables, c.keyValue(name, value))
+	}
+
+	return pkg, true
+}
+
+// keyValue maps a header or environment variable. A value that is exactly
+// one input placeholder becomes that input; anything else is a value
+// template with variables.
+func (c converter) keyValue(name, value string) KeyValueInput {
+	kv := KeyValueInput{Name: name}
+
+	if inputName, ok := soleInput(value); ok {
+		kv.Input = c.input(inputName)
+		return kv
+	}
+
+	kv.Value, kv.Variables = c.template(value)
+	kv.IsRequired = len(kv.Variables) > 0
+	for _, variable := range kv.Variables {
+		kv.IsSecret = kv.IsSecret || variable.IsSecret
+	}
+	return kv
+}
+
+// template turns ${input:name} placeholders into {name} variables
+func (c converter) template(value string) (string, map[string]Input) {
+	variables := map[string]Input{}
+	replaced := models.ReplaceInputPlaceholders(value, func(name string) string {
+		variables[name] = c.input(name)
+		return "{" + name + "}"
+	}).(string)
+
+	if len(variables) == 0 {
+		return replaced, nil
+	}
+	return replaced, variables
+}
+
+func (c converter) input(name string) Input {
+	declared, _ := c.server.FindInput(name)
+	return Input{
+		Description: declared.Description,
+		IsRequired:  declared.Required,
+		IsSecret:    declared.Secret,
+		Default:     declared.Default,
+	}
+}
+
+func soleInput(value string) (string, bool) {
+	name, ok := strings.CutPrefix(value, "${input:")
+	if !ok || !strings.HasSuffix(name, "}") {
+		return "", false
+	}
+	name = strings.TrimSuffix(name, "}")
+	return name, models.InputPlaceholder(name) == value
+}
+
+// splitVersion separates a pinned version from a package reference. A
+// separator at the start belongs to the name, as in @scope/package.
+func splitVersion(reference, separator string) (string, string) {
+	if i := strings.LastIndex(reference, separator); i > 0 {
+		return reference[:i], reference[i+len(separator):]
+	}
+	return reference, ""
+}
+
+func packageName(reference string) string {
+	name, _ := splitVersion(reference, "@")
+	return name
+}
+
+// positionalArgs drops the flags npx and uvx take before the package
+func positionalArgs(args []string) []string {
+	for i, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			return args[i:]
+		}
+	}
+	return nil
+}
+
+// dockerImage finds the image in the arguments of docker run and returns the
+// arguments passed to the container after it
+func dockerImage(args []string) (string, []string, bool) {
+	if len(args) == 0 || args[0] != "run" {
+		return "", nil, false
+	}
+
+	// Options of docker run that take a separate value
+	withValue := map[string]bool{"-e": true, "--env": true, "-v": true, "--volume": true, "-p": true,
+		"--publish": true, "--name": true, "--network": true, "-w": true, "--workdir": true, "--env-file": true}
+
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			return arg, args[i+1:], true
+		}
+		if withValue[arg] {
+			i++
+		}
+	}
+	return "", nil, false
+}
+
+func stringList(value interface{}) []string {
+	items, _ := value.([]interface{})
+	list := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+func sortedKeys(value interface{}) []string {
+	m, _ := value.(map[string]interface{})
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
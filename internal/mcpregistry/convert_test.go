@@ -0,0 +1,142 @@
+package mcpregistry
+
+import (
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func TestFromServer_Remote(t *testing.T) {
+	doc := FromServer(models.Server{
+		ID:          "5f1c2d9e",
+		Slug:        "github",
+		Description: "GitHub",
+		Transport:   "SSE",
+		URL:         "https://github.com/github/github-mcp-server",
+		Inputs:      []models.Input{{Name: "token", Required: true, Secret: true}},
+		Config: map[string]interface{}{
+			"github": map[string]interface{}{
+				"url":     "https://api.example.com/mcp/",
+				"headers": map[string]interface{}{"Authorization": "Bearer ${input:token}"},
+			},
+		},
+	}, "com.example", true)
+
+	if doc.Name != "com.example/github" {
+		t.Errorf("expected name com.example/github, got %q", doc.Name)
+	}
+	if doc.Version != UnversionedVersion {
+		t.Errorf("expected version %q, got %q", UnversionedVersion, doc.Version)
+	}
+	if doc.Repository == nil || doc.Repository.Source != "github" || doc.WebsiteURL != "" {
+		t.Errorf("expected a github repository, got %+v / %q", doc.Repository, doc.WebsiteURL)
+	}
+	if doc.Meta.Official.ServerID != "5f1c2d9e" || !doc.Meta.Official.IsLatest {
+		t.Errorf("unexpected official metadata %+v", doc.Meta.Official)
+	}
+
+	if len(doc.Remotes) != 1 || len(doc.Packages) != 0 {
+		t.Fatalf("expected one remote, got %d remotes and %d packages", len(doc.Remotes), len(doc.Packages))
+	}
+	remote := doc.Remotes[0]
+	if remote.Type != models.TransportSSE || remote.URL != "https://api.example.com/mcp/" {
+		t.Errorf("unexpected remote %+v", remote)
+	}
+
+	header := remote.Headers[0]
+	if header.Value != "Bearer {token}" || !header.IsRequired || !header.IsSecret {
+		t.Errorf("unexpected header %+v", header)
+	}
+	if variable, ok := header.Variables["token"]; !ok || !variable.IsSecret {
+		t.Errorf("expected a secret token variable, got %+v", header.Variables)
+	}
+}
+
+func TestFromServer_MCPRemoteBridge(t *testing.T) {
+	doc := FromServer(models.Server{
+		Slug:      "atlassian",
+		Transport: "streamable-http",
+		Config: map[string]interface{}{
+			"atlassian": map[string]interface{}{
+				"command": "npx",
+				"args":    []interface{}{"-y", "mcp-remote@0.1.13", "https://mcp.atlassian.com/v1/sse"},
+			},
+		},
+	}, "com.example", true)
+
+	if len(doc.Remotes) != 1 || doc.Remotes[0].URL != "https://mcp.atlassian.com/v1/sse" {
+		t.Fatalf("expected the bridged URL as a remote, got %+v", doc.Remotes)
+	}
+	if doc.Remotes[0].Type != models.TransportStreamableHTTP {
+		t.Errorf("expected a streamable-http remote, got %q", doc.Remotes[0].Type)
+	}
+}
+
+func TestFromServer_Packages(t *testing.T) {
+	doc := FromServer(models.Server{
+		Slug:      "tools",
+		Version:   "1.2.0",
+		Transport: "stdio",
+		URL:       "https://tools.example.com",
+		Inputs:    []models.Input{{Name: "root", Description: "Directory to serve", Default: "/srv"}},
+		Config: map[string]interface{}{
+			"a-npm": map[string]interface{}{
+				"command": "npx",
+				"args":    []interface{}{"-y", "@scope/server@1.2.0", "${input:root}"},
+				"env":     map[string]interface{}{"LOG_LEVEL": "debug", "ROOT": "${input:root}"},
+			},
+			"b-pypi": map[string]interface{}{
+				"command": "uvx",
+				"args":    []interface{}{"mcp-server-time==0.6.2"},
+			},
+			"c-oci": map[string]interface{}{
+				"command": "docker",
+				"args":    []interface{}{"run", "-i", "--rm", "-e", "TOKEN", "ghcr.io/example/server:2.0", "--verbose"},
+			},
+			"d-unsupported": map[string]interface{}{
+				"command": "/usr/local/bin/server",
+			},
+		},
+	}, "com.example", false)
+
+	if doc.WebsiteURL != "https://tools.example.com" || doc.Repository != nil {
+		t.Errorf("expected a website URL, got %+v / %q", doc.Repository, doc.WebsiteURL)
+	}
+	if len(doc.Packages) != 3 {
+		t.Fatalf("expected 3 packages, got %+v", doc.Packages)
+	}
+
+	expected := []struct{ registryType, identifier, version string }{
+		{"npm", "@scope/server", "1.2.0"},
+		{"pypi", "mcp-server-time", "0.6.2"},
+		{"oci", "ghcr.io/example/server", "2.0"},
+	}
+	for i, want := range expected {
+		pkg := doc.Packages[i]
+		if pkg.RegistryType != want.registryType || pkg.Identifier != want.identifier || pkg.Version != want.version {
+			t.Errorf("package %d: expected %+v, got %s %s %s", i, want, pkg.RegistryType, pkg.Identifier, pkg.Version)
+		}
+		if pkg.Transport.Type != models.TransportStdio {
+			t.Errorf("package %d: expected stdio transport, got %q", i, pkg.Transport.Type)
+		}
+	}
+
+	npm := doc.Packages[0]
+	if len(npm.PackageArguments) != 1 || npm.PackageArguments[0].Value != "{root}" {
+		t.Errorf("unexpected package arguments %+v", npm.PackageArguments)
+	}
+	if len(npm.EnvironmentVariables) != 2 {
+		t.Fatalf("expected 2 environment variables, got %+v", npm.EnvironmentVariables)
+	}
+	if env := npm.EnvironmentVariables[0]; env.Name != "LOG_LEVEL" || env.Value != "debug" || env.IsRequired {
+		t.Errorf("unexpected literal variable %+v", env)
+	}
+	if env := npm.EnvironmentVariables[1]; env.Value != "" || env.Default != "/srv" || env.Description != "Directory to serve" {
+		t.Errorf("expected ROOT to be the declared input, got %+v", env)
+	}
+
+	oci := doc.Packages[2]
+	if len(oci.PackageArguments) != 1 || oci.PackageArguments[0].Value != "--verbose" {
+		t.Errorf("expected the container arguments after the image, got %+v", oci.PackageArguments)
+	}
+}
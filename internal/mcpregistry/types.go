@@ -0,0 +1,105 @@
+// Package mcpregistry maps catalog records onto the shape used by the
+// community MCP Registry read API (server.json, schema 2025-09-29), so that
+// clients which speak that protocol can use this registry directly.
+package mcpregistry
+
+import "time"
+
+// SchemaURL identifies the server.json schema the documents follow
+const SchemaURL = "https://static.modelcontextprotocol.io/schemas/2025-09-29/server.schema.json"
+
+// StatusActive is the only status served: only approved servers are listed
+const StatusActive = "active"
+
+// ServerJSON describes one version of a server
+type ServerJSON struct {
+	Schema      string      `json:"$schema"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Version     string      `json:"version"`
+	Status      string      `json:"status,omitempty"`
+	Repository  *Repository `json:"repository,omitempty"`
+	WebsiteURL  string      `json:"websiteUrl,omitempty"`
+	Packages    []Package   `json:"packages,omitempty"`
+	Remotes     []Transport `json:"remotes,omitempty"`
+	Meta        *Meta       `json:"_meta,omitempty"`
+}
+
+type Repository struct {
+	URL    string `json:"url"`
+	Source string `json:"source"`
+}
+
+// Package is a locally run server distributed through a package registry
+type Package struct {
+	RegistryType         string          `json:"registryType"`
+	Identifier           string          `json:"identifier"`
+	Version              string          `json:"version,omitempty"`
+	RuntimeHint          string          `json:"runtimeHint,omitempty"`
+	Transport            Transport       `json:"transport"`
+	PackageArguments     []Argument      `json:"packageArguments,omitempty"`
+	EnvironmentVariables []KeyValueInput `json:"environmentVariables,omitempty"`
+}
+
+// Transport is how a client talks to a package or a remote server. URL and
+// header values may contain {variable} references.
+type Transport struct {
+	Type      string           `json:"type"`
+	URL       string           `json:"url,omitempty"`
+	Headers   []KeyValueInput  `json:"headers,omitempty"`
+	Variables map[string]Input `json:"variables,omitempty"`
+}
+
+// Input describes a value the user supplies
+type Input struct {
+	Description string `json:"description,omitempty"`
+	IsRequired  bool   `json:"isRequired,omitempty"`
+	IsSecret    bool   `json:"isSecret,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Value       string `json:"value,omitempty"`
+}
+
+// KeyValueInput is a named input such as an environment variable or header
+type KeyValueInput struct {
+	Name string `json:"name"`
+	Input
+	Variables map[string]Input `json:"variables,omitempty"`
+}
+
+// Argument types
+const (
+	ArgumentPositional = "positional"
+	ArgumentNamed      = "named"
+)
+
+type Argument struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+	Input
+	Variables map[string]Input `json:"variables,omitempty"`
+}
+
+// OfficialMetaKey is the _meta key of the registry-maintained metadata
+const OfficialMetaKey = "io.modelcontextprotocol.registry/official"
+
+type Meta struct {
+	Official *OfficialMeta `json:"io.modelcontextprotocol.registry/official,omitempty"`
+}
+
+type OfficialMeta struct {
+	ServerID    string    `json:"serverId"`
+	PublishedAt time.Time `json:"publishedAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	IsLatest    bool      `json:"isLatest"`
+}
+
+// ServerList is the response of the list and version listing endpoints
+type ServerList struct {
+	Servers  []ServerJSON `json:"servers"`
+	Metadata Metadata     `json:"metadata"`
+}
+
+type Metadata struct {
+	NextCursor string `json:"nextCursor,omitempty"`
+	Count      int    `json:"count"`
+}
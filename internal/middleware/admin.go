@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+)
+
+// AdminMiddleware only lets through requests that present the admin token as
+// a bearer credential. An empty token disables the check, which keeps local
+// development simple; production deployments must configure one.
+func AdminMiddleware(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := CheckAdminToken(token, r.Header); err != nil {
+			errors.WriteError(w, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// CheckAdminToken reports why a request with the given headers may not act as
+// an admin, or nil when it presents the admin token or no token is
+// configured. Routes that are only partly restricted use it in place of
+// AdminMiddleware.
+func CheckAdminToken(token string, header http.Header) error {
+	if token == "" {
+		return nil
+	}
+
+	presented, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return errors.NewAuthenticationError("Missing bearer token")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+		return errors.NewAuthorizationError("Invalid admin token")
+	}
+
+	return nil
+}
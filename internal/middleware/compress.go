@@ -0,0 +1,206 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+)
+
+// Content codings offered, in order of preference when a client accepts
+// several with the same weight
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+// minCompressSize is the smallest declared body worth compressing. Bodies of
+// unknown length are always compressed.
+const minCompressSize = 1024
+
+var gzipWriters = sync.Pool{New: func() any { return gzip.NewWriter(io.Discard) }}
+
+var brotliWriters = sync.Pool{New: func() any { return brotli.NewWriterLevel(io.Discard, 5) }}
+
+// CompressMiddleware compresses text responses with brotli or gzip, as
+// negotiated through Accept-Encoding. Flushes reach the client, so streamed
+// responses keep streaming.
+func CompressMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: w, encoding: negotiateEncoding(r.Header.Get("Accept-Encoding"))}
+		next.ServeHTTP(cw, r)
+		cw.close()
+	})
+}
+
+type compressWriter struct {
+	http.ResponseWriter
+	encoding    string
+	wroteHeader bool
+	encoder     interface {
+		io.WriteCloser
+		Flush() error
+	}
+}
+
+func (cw *compressWriter) WriteHeader(status int) {
+	if cw.wroteHeader {
+		return
+	}
+	cw.wroteHeader = true
+
+	header := cw.Header()
+	if compressible(header.Get("Content-Type")) {
+		header.Add("Vary", "Accept-Encoding")
+		if cw.encoding != "" && shouldCompress(status, header) {
+			cw.start()
+		}
+	}
+
+	cw.ResponseWriter.WriteHeader(status)
+}
+
+// start switches the response to the negotiated coding. The compressed bytes
+// are a different representation, so a validator set by the handler is
+// marked with the coding to keep it strong.
+func (cw *compressWriter) start() {
+	header := cw.Header()
+	header.Set("Content-Encoding", cw.encoding)
+	header.Del("Content-Length")
+	header.Del("Accept-Ranges")
+	if etag := header.Get("ETag"); strings.HasSuffix(etag, `"`) {
+		header.Set("ETag", strings.TrimSuffix(etag, `"`)+"-"+cw.encoding+`"`)
+	}
+
+	switch cw.encoding {
+	case encodingBrotli:
+		encoder := brotliWriters.Get().(*brotli.Writer)
+		encoder.Reset(cw.ResponseWriter)
+		cw.encoder = encoder
+	case encodingGzip:
+		encoder := gzipWriters.Get().(*gzip.Writer)
+		encoder.Reset(cw.ResponseWriter)
+		cw.encoder = encoder
+	}
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	if !cw.wroteHeader {
+		if cw.Header().Get("Content-Type") == "" {
+			cw.Header().Set("Content-Type", http.DetectContentType(p))
+		}
+		cw.WriteHeader(http.StatusOK)
+	}
+	if cw.encoder != nil {
+		return cw.encoder.Write(p)
+	}
+	return cw.ResponseWriter.Write(p)
+}
+
+// Flush sends what has been compressed so far on to the client
+func (cw *compressWriter) Flush() {
+	if cw.encoder != nil {
+		cw.encoder.Flush()
+	}
+	http.NewResponseController(cw.ResponseWriter).Flush()
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+// close writes the end of the compressed stream and returns the encoder to
+// its pool. It is not deferred: a handler that panics must not get a
+// well-formed ending.
+func (cw *compressWriter) close() {
+	if cw.encoder == nil {
+		return
+	}
+	cw.encoder.Close()
+
+	switch encoder := cw.encoder.(type) {
+	case *brotli.Writer:
+		brotliWriters.Put(encoder)
+	case *gzip.Writer:
+		gzipWriters.Put(encoder)
+	}
+	cw.encoder = nil
+}
+
+func shouldCompress(status int, header http.Header) bool {
+	if status < http.StatusOK || status == http.StatusNoContent ||
+		status == http.StatusPartialContent || status == http.StatusNotModified {
+		return false
+	}
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+	if length, err := strconv.Atoi(header.Get("Content-Length")); err == nil && length < minCompressSize {
+		return false
+	}
+	return true
+}
+
+// compressible reports whether a media type is text that compresses well.
+// Uploaded attachments and images are left alone.
+func compressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	if strings.HasPrefix(mediaType, "text/") || strings.HasSuffix(mediaType, "+json") || strings.HasSuffix(mediaType, "+xml") {
+		return true
+	}
+	switch mediaType {
+	case "application/json", "application/x-ndjson", "application/javascript", "application/xml", "image/svg+xml":
+		return true
+	}
+	return false
+}
+
+// negotiateEncoding picks the coding with the highest weight in an
+// Accept-Encoding header, preferring brotli on a tie. It returns "" when the
+// response should not be compressed.
+func negotiateEncoding(accept string) string {
+	weights := map[string]float64{}
+	for _, item := range strings.Split(accept, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(item), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		weights[name] = weight
+	}
+
+	best, bestWeight := "", 0.0
+	for _, coding := range []string{encodingBrotli, encodingGzip} {
+		weight, ok := weights[coding]
+		if !ok {
+			weight, ok = weights["*"]
+		}
+		if ok && weight > bestWeight {
+			best, bestWeight = coding, weight
+		}
+	}
+	return best
+}
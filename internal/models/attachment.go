@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Kinds of evidence that can be attached to a server
+const (
+	AttachmentSecurityReview = "security-review"
+	AttachmentThreatModel    = "threat-model"
+	AttachmentSBOM           = "sbom"
+	AttachmentPentestSummary = "pentest-summary"
+	AttachmentOther          = "other"
+)
+
+// AttachmentKinds lists every allowed attachment kind
+var AttachmentKinds = []string{AttachmentSecurityReview, AttachmentThreatModel, AttachmentSBOM, AttachmentPentestSummary, AttachmentOther}
+
+// Attachment describes a file attached to a server. The content lives in the
+// blob store under SHA256; the record only keeps what is needed to list and
+// serve it.
+type Attachment struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Kind        string    `json:"kind"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	SHA256      string    `json:"sha256"`
+	UploadedAt  time.Time `json:"uploadedAt"`
+}
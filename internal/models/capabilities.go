@@ -0,0 +1,66 @@
+package models
+
+// Tool describes a tool a server exposes, mirroring an entry of the MCP
+// tools/list result
+type Tool struct {
+	Name        string                 `json:"name"`
+	Title       string                 `json:"title,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	InputSchema map[string]interface{} `json:"inputSchema,omitempty"`
+	Annotations *ToolAnnotations       `json:"annotations,omitempty"`
+}
+
+// ToolAnnotations are the behavioural hints a server publishes for a tool.
+// Unset hints take the defaults defined by the MCP specification.
+type ToolAnnotations struct {
+	Title           string `json:"title,omitempty"`
+	ReadOnlyHint    *bool  `json:"readOnlyHint,omitempty"`
+	DestructiveHint *bool  `json:"destructiveHint,omitempty"`
+	IdempotentHint  *bool  `json:"idempotentHint,omitempty"`
+	OpenWorldHint   *bool  `json:"openWorldHint,omitempty"`
+}
+
+// IsReadOnly reports whether the tool claims not to modify its environment
+func (t Tool) IsReadOnly() bool {
+	return t.Annotations != nil && t.Annotations.ReadOnlyHint != nil && *t.Annotations.ReadOnlyHint
+}
+
+// IsDestructive reports whether the tool may perform destructive updates.
+// MCP treats tools that are not read-only as destructive unless told otherwise.
+func (t Tool) IsDestructive() bool {
+	if t.IsReadOnly() {
+		return false
+	}
+	return t.Annotations == nil || t.Annotations.DestructiveHint == nil || *t.Annotations.DestructiveHint
+}
+
+// IsIdempotent reports whether repeating a call with the same arguments has
+// no additional effect
+func (t Tool) IsIdempotent() bool {
+	return !t.IsReadOnly() && t.Annotations != nil && t.Annotations.IdempotentHint != nil && *t.Annotations.IdempotentHint
+}
+
+// Prompt describes a prompt template a server exposes
+type Prompt struct {
+	Name        string           `json:"name"`
+	Title       string           `json:"title,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Arguments   []PromptArgument `json:"arguments,omitempty"`
+}
+
+// PromptArgument is a value a prompt can be parameterised with
+type PromptArgument struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+// ResourceTemplate describes a family of resources a server exposes through
+// an RFC 6570 URI template
+type ResourceTemplate struct {
+	URITemplate string `json:"uriTemplate"`
+	Name        string `json:"name"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
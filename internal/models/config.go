@@ -1,8 +1,35 @@
 package models
 
+import "time"
+
 type Config struct {
 	StorageType  string `json:"storage_type"`
 	StoragePath  string `json:"storage_path"`
 	TemplatePath string `json:"template_path"`
 	LogLevel     string `json:"log_level"`
+
+	BlobStoreType     string `json:"blob_store_type"`
+	BlobPath          string `json:"blob_path"`
+	MaxAttachmentSize int64  `json:"max_attachment_size"`
+	AdminToken        string `json:"admin_token"`
+
+	// RegistryNamespace prefixes server names in the MCP Registry API, e.g.
+	// com.example/github
+	RegistryNamespace string `json:"registry_namespace"`
+
+	RiskWeightsPath string      `json:"risk_weights_path"`
+	RiskWeights     RiskWeights `json:"-"`
+
+	LicensePolicyPath string        `json:"license_policy_path"`
+	LicensePolicy     LicensePolicy `json:"-"`
+
+	// IdempotencyWindow is how long the response to a request made with an
+	// Idempotency-Key is replayed to its retries
+	IdempotencyWindow time.Duration `json:"idempotency_window"`
+
+	// V1Deprecated is when v1 of the server API is deprecated and V1Sunset
+	// when it is expected to stop being served. v1 is supported while
+	// V1Deprecated is zero.
+	V1Deprecated time.Time `json:"v1_deprecated"`
+	V1Sunset     time.Time `json:"v1_sunset"`
 }
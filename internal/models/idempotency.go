@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// IdempotencyRecord is the stored response to a request made with an
+// Idempotency-Key. Retries of the same request are answered with it until it
+// expires, instead of being carried out again.
+type IdempotencyRecord struct {
+	// ID identifies the key within the scope of the client that sent it
+	ID string `json:"id"`
+	// Fingerprint is a digest of the method, URL and body of the request
+	Fingerprint string              `json:"fingerprint"`
+	StatusCode  int                 `json:"statusCode"`
+	Header      map[string][]string `json:"header,omitempty"`
+	Body        []byte              `json:"body,omitempty"`
+	CreatedAt   time.Time           `json:"createdAt"`
+	ExpiresAt   time.Time           `json:"expiresAt"`
+}
@@ -0,0 +1,77 @@
+package models
+
+import "regexp"
+
+// Input declares a value a server needs from whoever installs it, such as an
+// API key, a base URL or a tenant ID. Inputs are referenced from the transport
+// config with ${input:<name>} placeholders so that the catalog never has to
+// hold the literal value.
+type Input struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Secret      bool   `json:"secret,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Pattern     string `json:"pattern,omitempty"` // regular expression the whole value must match
+}
+
+// inputPlaceholder matches ${input:<name>} references inside config values
+var inputPlaceholder = regexp.MustCompile(`\$\{input:([A-Za-z0-9_.-]+)\}`)
+
+// InputPlaceholder returns the placeholder used to reference the named input
+func InputPlaceholder(name string) string {
+	return "${input:" + name + "}"
+}
+
+// FindInput returns the declared input with the given name
+func (s Server) FindInput(name string) (Input, bool) {
+	for _, input := range s.Inputs {
+		if input.Name == name {
+			return input, true
+		}
+	}
+	return Input{}, false
+}
+
+// InputReferences returns the names of all inputs referenced from the config,
+// in order of first appearance and without duplicates
+func (s Server) InputReferences() []string {
+	var names []string
+	seen := map[string]bool{}
+
+	ReplaceInputPlaceholders(s.Config, func(name string) string {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+		return InputPlaceholder(name)
+	})
+
+	return names
+}
+
+// ReplaceInputPlaceholders walks a decoded JSON value and returns a copy in
+// which every ${input:<name>} placeholder inside a string has been replaced by
+// the result of replace. The original value is left untouched.
+func ReplaceInputPlaceholders(value interface{}, replace func(name string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return inputPlaceholder.ReplaceAllStringFunc(v, func(match string) string {
+			return replace(inputPlaceholder.FindStringSubmatch(match)[1])
+		})
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			out[key] = ReplaceInputPlaceholders(item, replace)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = ReplaceInputPlaceholders(item, replace)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReplaceInputPlaceholders(t *testing.T) {
+	values := map[string]string{"token": "s3cret", "host": "api.example.com"}
+	replace := func(name string) string {
+		if value, ok := values[name]; ok {
+			return value
+		}
+		return InputPlaceholder(name)
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  interface{}
+	}{
+		{"plain string", "no placeholders", "no placeholders"},
+		{"whole value", "${input:token}", "s3cret"},
+		{"repeated", "${input:host}/${input:host}", "api.example.com/api.example.com"},
+		{"embedded", "Bearer ${input:token}", "Bearer s3cret"},
+		{"unknown input kept", "${input:tenant}", "${input:tenant}"},
+		{"malformed left alone", "${input:} ${input token}", "${input:} ${input token}"},
+		{"non-string", 42.0, 42.0},
+		{
+			"nested",
+			map[string]interface{}{
+				"args": []interface{}{"--host", "${input:host}", true},
+				"env":  map[string]interface{}{"TOKEN": "${input:token}", "TENANT": "${input:tenant}"},
+			},
+			map[string]interface{}{
+				"args": []interface{}{"--host", "api.example.com", true},
+				"env":  map[string]interface{}{"TOKEN": "s3cret", "TENANT": "${input:tenant}"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := ReplaceInputPlaceholders(tt.value, replace); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %#v, want %#v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestReplaceInputPlaceholders_LeavesOriginalUntouched(t *testing.T) {
+	config := map[string]interface{}{"env": map[string]interface{}{"TOKEN": "${input:token}"}}
+
+	ReplaceInputPlaceholders(config, func(string) string { return "s3cret" })
+
+	if got := config["env"].(map[string]interface{})["TOKEN"]; got != "${input:token}" {
+		t.Errorf("expected the original config to keep its placeholder, got %v", got)
+	}
+}
+
+func TestInputReferences(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		want   []string
+	}{
+		{"no config", nil, nil},
+		{"no placeholders", map[string]interface{}{"command": "server"}, nil},
+		{
+			"first appearance order without duplicates",
+			map[string]interface{}{
+				"args": []interface{}{"${input:host}", "--token=${input:token}", "${input:host}"},
+			},
+			[]string{"host", "token"},
+		},
+		{
+			"nested and undeclared",
+			map[string]interface{}{
+				"env": map[string]interface{}{"A": map[string]interface{}{"B": []interface{}{"${input:tenant}"}}},
+			},
+			[]string{"tenant"},
+		},
+	}
+
+	for _, tt := range tests {
+		server := Server{Config: tt.config, Inputs: []Input{{Name: "host"}, {Name: "token"}}}
+		if got := server.InputReferences(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// License policy decisions, from most to least permissive
+const (
+	LicenseAllowed     = "allowed"
+	LicenseNeedsReview = "needs-review"
+	LicenseForbidden   = "forbidden"
+)
+
+// LicenseDecisions lists every license policy decision
+var LicenseDecisions = []string{LicenseAllowed, LicenseNeedsReview, LicenseForbidden}
+
+// LicensePolicy sorts SPDX license identifiers into policy decisions. An
+// entry ending in * matches every identifier with that prefix, e.g. GPL-*.
+// Licenses matched by no entry, including LicenseRef- identifiers, get the
+// Default decision.
+type LicensePolicy struct {
+	Allowed     []string `json:"allowed"`
+	NeedsReview []string `json:"needsReview"`
+	Forbidden   []string `json:"forbidden"`
+	Default     string   `json:"default"`
+}
+
+// LicenseReview records that legal reviewed the license of a server. It only
+// counts for the exact license expression that was reviewed.
+type LicenseReview struct {
+	License    string    `json:"license"`
+	Reviewer   string    `json:"reviewer"`
+	ReviewedAt time.Time `json:"reviewedAt"`
+	Note       string    `json:"note,omitempty"`
+}
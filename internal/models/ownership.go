@@ -0,0 +1,52 @@
+package models
+
+// Vendor types describe who builds and maintains a server
+const (
+	VendorInternal   = "internal"
+	VendorThirdParty = "third-party"
+	VendorCommunity  = "community"
+)
+
+// VendorTypes lists every allowed vendor type
+var VendorTypes = []string{VendorInternal, VendorThirdParty, VendorCommunity}
+
+// Support tiers describe the level of support the owning team commits to
+const (
+	SupportTierCritical   = "tier-1"
+	SupportTierStandard   = "tier-2"
+	SupportTierBasic      = "tier-3"
+	SupportTierBestEffort = "best-effort"
+)
+
+// SupportTiers lists every allowed support tier
+var SupportTiers = []string{SupportTierCritical, SupportTierStandard, SupportTierBasic, SupportTierBestEffort}
+
+// Ownership records who is responsible for a server and how to reach them
+// when it misbehaves
+type Ownership struct {
+	Team              string   `json:"team,omitempty"`
+	TechnicalContact  *Contact `json:"technicalContact,omitempty"`
+	BusinessContact   *Contact `json:"businessContact,omitempty"`
+	Vendor            *Vendor  `json:"vendor,omitempty"`
+	SupportTier       string   `json:"supportTier,omitempty"`
+	EscalationChannel string   `json:"escalationChannel,omitempty"`
+	Documentation     []Link   `json:"documentation,omitempty"`
+}
+
+// Contact is a person or rota that can be reached about a server
+type Contact struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// Vendor identifies the organisation that builds a server
+type Vendor struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Link is a titled reference to external documentation
+type Link struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// Profile is a named bundle of approved servers defined by an admin, such as
+// "frontend-dev", that can be installed into a host in one go
+type Profile struct {
+	Slug        string    `json:"slug"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Servers     []string  `json:"servers"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
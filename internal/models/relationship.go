@@ -0,0 +1,36 @@
+package models
+
+// Relationship types a server can declare towards another server
+const (
+	RelationReplaces      = "replaces"
+	RelationReplacedBy    = "replaced-by"
+	RelationAlternativeTo = "alternative-to"
+	RelationDependsOn     = "depends-on"
+	RelationBundledWith   = "bundled-with"
+)
+
+// RelationshipTypes lists every relationship type that can be stored
+var RelationshipTypes = []string{RelationReplaces, RelationReplacedBy, RelationAlternativeTo, RelationDependsOn, RelationBundledWith}
+
+// Relationship points from the server that declares it to another server,
+// identified by its ID
+type Relationship struct {
+	Type   string `json:"type"`
+	Target string `json:"target"`
+	Note   string `json:"note,omitempty"`
+}
+
+// CanonicalRelationship normalises a declared relationship so that the same
+// link declared from either end compares equal: replaced-by is flipped into
+// replaces, and symmetric relationships are ordered by server ID.
+func CanonicalRelationship(source string, relationship Relationship) (from, to, relationType string) {
+	switch relationship.Type {
+	case RelationReplacedBy:
+		return relationship.Target, source, RelationReplaces
+	case RelationAlternativeTo, RelationBundledWith:
+		if relationship.Target < source {
+			return relationship.Target, source, relationship.Type
+		}
+	}
+	return source, relationship.Target, relationship.Type
+}
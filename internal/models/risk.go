@@ -0,0 +1,89 @@
+package models
+
+// Data classifications a server can touch, from least to most sensitive
+const (
+	DataPublic       = "public"
+	DataInternal     = "internal"
+	DataConfidential = "confidential"
+	DataRestricted   = "restricted"
+)
+
+// DataClassifications lists every allowed data classification
+var DataClassifications = []string{DataPublic, DataInternal, DataConfidential, DataRestricted}
+
+// Network egress describes where a server can send data
+const (
+	EgressNone     = "none"
+	EgressInternal = "internal"
+	EgressInternet = "internet"
+)
+
+// NetworkEgresses lists every allowed network egress value
+var NetworkEgresses = []string{EgressNone, EgressInternal, EgressInternet}
+
+// Authentication models a server uses towards the system it wraps
+const (
+	AuthNone   = "none"
+	AuthAPIKey = "api-key"
+	AuthOAuth  = "oauth"
+	AuthSSO    = "sso"
+)
+
+// AuthenticationModels lists every allowed authentication model
+var AuthenticationModels = []string{AuthNone, AuthAPIKey, AuthOAuth, AuthSSO}
+
+// Hosting locations describe where a server runs
+const (
+	HostingLocal    = "local"    // stdio process on the developer's machine
+	HostingInternal = "internal" // remote, operated by us
+	HostingVendor   = "vendor"   // remote, operated by a third party
+)
+
+// HostingLocations lists every allowed hosting location
+var HostingLocations = []string{HostingLocal, HostingInternal, HostingVendor}
+
+// Risk levels derived from the risk score
+const (
+	RiskLow      = "low"
+	RiskMedium   = "medium"
+	RiskHigh     = "high"
+	RiskCritical = "critical"
+)
+
+// Risk holds the facts the approval board needs to judge how dangerous a
+// server is
+type Risk struct {
+	DataClassifications []string `json:"dataClassifications,omitempty"`
+	CanWrite            bool     `json:"canWrite"`
+	CanDelete           bool     `json:"canDelete"`
+	NetworkEgress       string   `json:"networkEgress,omitempty"`
+	Authentication      string   `json:"authentication,omitempty"`
+	Hosting             string   `json:"hosting,omitempty"`
+}
+
+// RiskAssessment is the score computed from a server's Risk. It is derived
+// on every read and never stored.
+type RiskAssessment struct {
+	Score int    `json:"score"`
+	Level string `json:"level"`
+}
+
+// RiskWeights configures how much each risk factor adds to the score. A
+// factor that has not been declared on the server scores Unknown, so missing
+// information is never treated as safe.
+type RiskWeights struct {
+	DataClassifications map[string]int `json:"data_classifications"`
+	Write               int            `json:"write"`
+	Delete              int            `json:"delete"`
+	NetworkEgress       map[string]int `json:"network_egress"`
+	Authentication      map[string]int `json:"authentication"`
+	Hosting             map[string]int `json:"hosting"`
+	Unknown             int            `json:"unknown"`
+	Levels              []RiskLevel    `json:"levels"`
+}
+
+// RiskLevel names the level reached once the score is at least MinScore
+type RiskLevel struct {
+	Name     string `json:"name"`
+	MinScore int    `json:"min_score"`
+}
@@ -3,11 +3,39 @@ package models
 import "time"
 
 type Server struct {
-	Name        string                 `json:"name"`
-	Description string                 `json:"description"`
-	Transport   string                 `json:"transport"`
-	Status      string                 `json:"status"`
-	CreatedAt   time.Time              `json:"createdAt"`
-	URL         string                 `json:"url"`
-	Config      map[string]interface{} `json:"config,omitempty"`
+	// ID never changes once assigned; Slug follows Name and is what URLs use.
+	// PreviousSlugs keeps old slugs alive so that links survive renames.
+	ID            string   `json:"id"`
+	Slug          string   `json:"slug"`
+	PreviousSlugs []string `json:"previousSlugs,omitempty"`
+
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	Version       string                 `json:"version,omitempty"`
+	Transport     string                 `json:"transport"`
+	Status        string                 `json:"status"`
+	CreatedAt     time.Time              `json:"createdAt"`
+	UpdatedAt     time.Time              `json:"updatedAt"`
+	URL           string                 `json:"url"`
+	License       string                 `json:"license,omitempty"`
+	LicenseReview *LicenseReview         `json:"licenseReview,omitempty"`
+	Tags          []string               `json:"tags,omitempty"`
+	Categories    []string               `json:"categories,omitempty"`
+	Ownership     *Ownership             `json:"ownership,omitempty"`
+	Risk          *Risk                  `json:"risk,omitempty"`
+	Relationships []Relationship         `json:"relationships,omitempty"`
+	Inputs        []Input                `json:"inputs,omitempty"`
+	Attachments   []Attachment           `json:"attachments,omitempty"`
+	Config        map[string]interface{} `json:"config,omitempty"`
+
+	Tools             []Tool             `json:"tools,omitempty"`
+	Prompts           []Prompt           `json:"prompts,omitempty"`
+	ResourceTemplates []ResourceTemplate `json:"resourceTemplates,omitempty"`
+
+	// RiskAssessment is computed from Risk when the server is read
+	RiskAssessment *RiskAssessment `json:"riskAssessment,omitempty"`
+
+	// LicenseDecision is the license policy decision for License, computed
+	// when the server is read
+	LicenseDecision string `json:"licenseDecision,omitempty"`
 }
@@ -0,0 +1,23 @@
+package models
+
+import "strings"
+
+// Slugify turns a display name into a lower-case, URL-safe slug made of
+// letters, digits and single hyphens
+func Slugify(name string) string {
+	var b strings.Builder
+	hyphen := false
+
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			hyphen = false
+		case b.Len() > 0 && !hyphen:
+			b.WriteByte('-')
+			hyphen = true
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "-")
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"slices"
+	"strings"
+)
+
+// Server statuses, following a record through the approval workflow
+const (
+	StatusNew        = "new"
+	StatusInReview   = "in-review"
+	StatusApproved   = "approved"
+	StatusRejected   = "rejected"
+	StatusDeprecated = "deprecated"
+	StatusRevoked    = "revoked"
+)
+
+// Statuses lists every allowed server status
+var Statuses = []string{StatusNew, StatusInReview, StatusApproved, StatusRejected, StatusDeprecated, StatusRevoked}
+
+// StatusTransitions lists the statuses each status can move to. Deprecated
+// servers can be reinstated; revoked ones have to go through review again.
+var StatusTransitions = map[string][]string{
+	StatusNew:        {StatusInReview, StatusRejected},
+	StatusInReview:   {StatusNew, StatusApproved, StatusRejected},
+	StatusApproved:   {StatusInReview, StatusDeprecated, StatusRevoked},
+	StatusRejected:   {StatusNew, StatusInReview},
+	StatusDeprecated: {StatusApproved, StatusRevoked},
+	StatusRevoked:    {StatusInReview},
+}
+
+// CanTransition reports whether a server may move from one status to another.
+// Staying in the same status is always allowed.
+func CanTransition(from, to string) bool {
+	return from == to || slices.Contains(StatusTransitions[from], to)
+}
+
+// Transports describe how an MCP client talks to a server
+const (
+	TransportStdio          = "stdio"
+	TransportSSE            = "sse"
+	TransportStreamableHTTP = "streamable-http"
+)
+
+// Transports lists every allowed server transport
+var Transports = []string{TransportStdio, TransportSSE, TransportStreamableHTTP}
+
+// TransportType returns the server's transport in its canonical lower-case
+// form, so that records written as "SSE" and "sse" are treated alike
+func (s Server) TransportType() string {
+	return strings.ToLower(s.Transport)
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Tag is an entry in the managed tag vocabulary. Servers reference tags by slug.
+type Tag struct {
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// Category is a broad grouping of servers, such as "Source control". Servers
+// reference categories by slug.
+type Category struct {
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// Collection is a curated, ordered list of servers put together by an admin,
+// e.g. "Recommended for backend engineers"
+type Collection struct {
+	Slug        string    `json:"slug"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Servers     []string  `json:"servers"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
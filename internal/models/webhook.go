@@ -0,0 +1,60 @@
+package models
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// Webhook is a subscription of an outside system to catalog events. Payloads
+// are signed with the secret, which is only ever shown when it is created.
+type Webhook struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events,omitempty"` // event types to deliver, all when empty
+	Secret      string    `json:"secret,omitempty"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// Wants reports whether the webhook subscribes to an event type
+func (w Webhook) Wants(eventType string) bool {
+	return len(w.Events) == 0 || slices.Contains(w.Events, eventType)
+}
+
+// Delivery states. A delivery is pending while attempts remain, and is
+// dead-lettered once they have all failed.
+const (
+	DeliveryPending    = "pending"
+	DeliveryDelivered  = "delivered"
+	DeliveryDeadLetter = "dead_letter"
+)
+
+// DeliveryStatuses lists every delivery state
+var DeliveryStatuses = []string{DeliveryPending, DeliveryDelivered, DeliveryDeadLetter}
+
+// Delivery is the log of sending one event to one webhook
+type Delivery struct {
+	ID            string            `json:"id"`
+	WebhookID     string            `json:"webhookId"`
+	EventID       string            `json:"eventId"`
+	EventType     string            `json:"eventType"`
+	ReplayOf      string            `json:"replayOf,omitempty"` // the delivery this one replays
+	Payload       json.RawMessage   `json:"payload"`
+	Status        string            `json:"status"`
+	Attempts      []DeliveryAttempt `json:"attempts"`
+	NextAttemptAt *time.Time        `json:"nextAttemptAt,omitempty"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	UpdatedAt     time.Time         `json:"updatedAt"`
+}
+
+// DeliveryAttempt records one request made for a delivery. Error is set when
+// no response came back; the response body is cut short.
+type DeliveryAttempt struct {
+	At           time.Time `json:"at"`
+	StatusCode   int       `json:"statusCode,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	ResponseBody string    `json:"responseBody,omitempty"`
+	DurationMs   int64     `json:"durationMs"`
+}
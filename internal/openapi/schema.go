@@ -0,0 +1,204 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// Generator derives JSON schemas from Go types by following their json tags,
+// the same way encoding/json does. Named struct types become component
+// schemas that are referenced wherever the type appears.
+type Generator struct {
+	schemas   map[string]*Schema
+	names     map[reflect.Type]string
+	overrides map[reflect.Type]map[string][]func(*Schema)
+}
+
+func NewGenerator() *Generator {
+	return &Generator{
+		schemas:   map[string]*Schema{},
+		names:     map[reflect.Type]string{},
+		overrides: map[reflect.Type]map[string][]func(*Schema){},
+	}
+}
+
+// Override adjusts the schema of one property of a struct type, identified
+// by its JSON name. It must be called before the type is first generated.
+func (g *Generator) Override(v any, property string, adjust ...func(*Schema)) {
+	t := reflect.TypeOf(v)
+	if g.overrides[t] == nil {
+		g.overrides[t] = map[string][]func(*Schema){}
+	}
+	g.overrides[t][property] = append(g.overrides[t][property], adjust...)
+}
+
+// Enum restricts a property to the given values
+func Enum(values []string) func(*Schema) {
+	return func(s *Schema) {
+		if s.Type == "array" && s.Items != nil {
+			s.Items.Enum = values
+			return
+		}
+		s.Enum = values
+	}
+}
+
+// ReadOnly marks a property as set by the server and ignored in requests
+func ReadOnly(s *Schema) {
+	s.ReadOnly = true
+}
+
+// Describe sets the description of a property
+func Describe(description string) func(*Schema) {
+	return func(s *Schema) {
+		s.Description = description
+	}
+}
+
+// Schema returns the schema for the type of v
+func (g *Generator) Schema(v any) *Schema {
+	return g.schemaFor(reflect.TypeOf(v))
+}
+
+// Schemas returns the component schemas generated so far
+func (g *Generator) Schemas() map[string]*Schema {
+	return g.schemas
+}
+
+func (g *Generator) schemaFor(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+	if t == rawMessageType {
+		// Raw JSON is passed through as whatever value it holds
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return ArrayOf(g.schemaFor(t.Elem()))
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return Ref(g.component(t))
+	}
+
+	// interface{} and anything else accepts any JSON value
+	return &Schema{}
+}
+
+// component registers a named struct type as a component schema and returns
+// its name
+func (g *Generator) component(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+
+	name := exportedName(t.Name())
+	if _, taken := g.schemas[name]; taken {
+		// Same type name in another package
+		name = exportedName(pathBase(t.PkgPath())) + name
+	}
+
+	g.names[t] = name
+	g.schemas[name] = &Schema{} // placeholder so recursive types terminate
+	*g.schemas[name] = *g.structSchema(t)
+
+	return name
+}
+
+func (g *Generator) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	g.addFields(schema, t, g.overrides[t])
+	return schema
+}
+
+// addFields adds the properties of t to schema, flattening embedded structs
+// like encoding/json does
+func (g *Generator) addFields(schema *Schema, t reflect.Type, overrides map[string][]func(*Schema)) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			g.addFields(schema, fieldType, g.overrides[fieldType])
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		property := g.schemaFor(field.Type)
+		if adjustments := overrides[name]; len(adjustments) > 0 {
+			if property.Ref != "" {
+				// Siblings of $ref are allowed in 3.1, but keep the shared
+				// component untouched
+				property = &Schema{Ref: property.Ref}
+			}
+			for _, adjust := range adjustments {
+				adjust(property)
+			}
+		}
+		schema.Properties[name] = property
+
+		if !strings.Contains(options, "omitempty") && !strings.Contains(options, "omitzero") && field.Type.Kind() != reflect.Pointer {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+func exportedName(name string) string {
+	if name == "" {
+		return name
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+func pathBase(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
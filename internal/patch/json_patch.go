@@ -0,0 +1,357 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// JSON Patch operations
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+	OpMove    = "move"
+	OpCopy    = "copy"
+	OpTest    = "test"
+)
+
+// Operation is a single JSON Patch operation
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// JSONPatch is an RFC 6902 patch: operations applied in order
+type JSONPatch []Operation
+
+// ParseJSONPatch decodes and checks a JSON Patch document
+func ParseJSONPatch(data []byte) (JSONPatch, error) {
+	var operations []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &operations); err != nil {
+		return nil, fmt.Errorf("%w: a JSON Patch must be an array of operations: %v", ErrInvalidPatch, err)
+	}
+
+	patch := make(JSONPatch, 0, len(operations))
+	for i, fields := range operations {
+		var operation Operation
+		for name, target := range map[string]*string{"op": &operation.Op, "path": &operation.Path, "from": &operation.From} {
+			if raw, ok := fields[name]; ok {
+				if err := json.Unmarshal(raw, target); err != nil {
+					return nil, fmt.Errorf("%w: operation %d: %s must be a string", ErrInvalidPatch, i, name)
+				}
+			}
+		}
+		// A null value is a value, so it cannot be told apart by omitempty
+		value, hasValue := fields["value"]
+		operation.Value = value
+
+		if _, ok := fields["path"]; !ok {
+			return nil, fmt.Errorf("%w: operation %d: path is required", ErrInvalidPatch, i)
+		}
+		switch operation.Op {
+		case OpAdd, OpReplace, OpTest:
+			if !hasValue {
+				return nil, fmt.Errorf("%w: operation %d: %s requires a value", ErrInvalidPatch, i, operation.Op)
+			}
+		case OpMove, OpCopy:
+			if _, ok := fields["from"]; !ok {
+				return nil, fmt.Errorf("%w: operation %d: %s requires from", ErrInvalidPatch, i, operation.Op)
+			}
+		case OpRemove:
+		default:
+			return nil, fmt.Errorf("%w: operation %d: unknown op %q", ErrInvalidPatch, i, operation.Op)
+		}
+
+		for _, pointer := range []string{operation.Path, operation.From} {
+			if pointer != "" && !strings.HasPrefix(pointer, "/") {
+				return nil, fmt.Errorf("%w: operation %d: %q is not a JSON pointer", ErrInvalidPatch, i, pointer)
+			}
+		}
+
+		patch = append(patch, operation)
+	}
+
+	return patch, nil
+}
+
+// Apply applies every operation to the document in order. If any operation
+// fails, the error says which and the document is not changed.
+func (p JSONPatch) Apply(document []byte) ([]byte, error) {
+	root, err := decode(document)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, operation := range p {
+		if root, err = operation.apply(root); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, operation.Op, operation.Path, err)
+		}
+	}
+
+	return json.Marshal(root)
+}
+
+func (o Operation) apply(root any) (any, error) {
+	switch o.Op {
+	case OpAdd:
+		value, err := o.value()
+		if err != nil {
+			return nil, err
+		}
+		return add(root, o.Path, value)
+	case OpRemove:
+		root, _, err := remove(root, o.Path)
+		return root, err
+	case OpReplace:
+		value, err := o.value()
+		if err != nil {
+			return nil, err
+		}
+		if o.Path == "" {
+			return value, nil
+		}
+		if root, _, err = remove(root, o.Path); err != nil {
+			return nil, err
+		}
+		return add(root, o.Path, value)
+	case OpMove:
+		if o.Path != o.From && strings.HasPrefix(o.Path, o.From+"/") {
+			return nil, fmt.Errorf("%w: cannot move a value into itself", ErrNotApplicable)
+		}
+		root, value, err := remove(root, o.From)
+		if err != nil {
+			return nil, err
+		}
+		return add(root, o.Path, value)
+	case OpCopy:
+		value, err := get(root, o.From)
+		if err != nil {
+			return nil, err
+		}
+		return add(root, o.Path, deepCopy(value))
+	case OpTest:
+		expected, err := o.value()
+		if err != nil {
+			return nil, err
+		}
+		actual, err := get(root, o.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(actual, expected) {
+			return nil, ErrTestFailed
+		}
+		return root, nil
+	}
+	return nil, fmt.Errorf("%w: unknown op %q", ErrInvalidPatch, o.Op)
+}
+
+func (o Operation) value() (any, error) {
+	value, err := decode(o.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	return value, nil
+}
+
+// parsePointer splits an RFC 6901 JSON pointer into unescaped tokens
+func parsePointer(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens
+}
+
+func get(root any, pointer string) (any, error) {
+	value := root
+	for _, token := range parsePointer(pointer) {
+		switch container := value.(type) {
+		case map[string]any:
+			member, ok := container[token]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s does not exist", ErrNotApplicable, pointer)
+			}
+			value = member
+		case []any:
+			index, err := arrayIndex(token, len(container)-1)
+			if err != nil {
+				return nil, err
+			}
+			value = container[index]
+		default:
+			return nil, fmt.Errorf("%w: %s does not exist", ErrNotApplicable, pointer)
+		}
+	}
+	return value, nil
+}
+
+// add sets the value at pointer, inserting into arrays, and returns the new
+// root. The parent of the target must exist.
+func add(root any, pointer string, value any) (any, error) {
+	tokens := parsePointer(pointer)
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	return update(root, tokens, func(parent any, last string) (any, error) {
+		switch container := parent.(type) {
+		case map[string]any:
+			container[last] = value
+			return container, nil
+		case []any:
+			index := len(container)
+			if last != "-" {
+				var err error
+				if index, err = arrayIndex(last, len(container)); err != nil {
+					return nil, err
+				}
+			}
+			return slices.Insert(container, index, value), nil
+		}
+		return nil, fmt.Errorf("%w: the parent of %s is not an object or array", ErrNotApplicable, pointer)
+	})
+}
+
+// remove deletes the value at pointer and returns the new root along with
+// the removed value
+func remove(root any, pointer string) (any, any, error) {
+	tokens := parsePointer(pointer)
+	if len(tokens) == 0 {
+		return nil, nil, fmt.Errorf("%w: the whole document cannot be removed", ErrNotApplicable)
+	}
+
+	var removed any
+	root, err := update(root, tokens, func(parent any, last string) (any, error) {
+		switch container := parent.(type) {
+		case map[string]any:
+			value, ok := container[last]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s does not exist", ErrNotApplicable, pointer)
+			}
+			removed = value
+			delete(container, last)
+			return container, nil
+		case []any:
+			index, err := arrayIndex(last, len(container)-1)
+			if err != nil {
+				return nil, err
+			}
+			removed = container[index]
+			return slices.Delete(container, index, index+1), nil
+		}
+		return nil, fmt.Errorf("%w: %s does not exist", ErrNotApplicable, pointer)
+	})
+	return root, removed, err
+}
+
+// update walks to the parent of the last token, lets change replace the
+// parent and stores the result back, since changing an array may reallocate it
+func update(value any, tokens []string, change func(parent any, last string) (any, error)) (any, error) {
+	if len(tokens) == 1 {
+		return change(value, tokens[0])
+	}
+
+	token := tokens[0]
+	switch container := value.(type) {
+	case map[string]any:
+		child, ok := container[token]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s does not exist", ErrNotApplicable, token)
+		}
+		updated, err := update(child, tokens[1:], change)
+		if err != nil {
+			return nil, err
+		}
+		container[token] = updated
+		return container, nil
+	case []any:
+		index, err := arrayIndex(token, len(container)-1)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := update(container[index], tokens[1:], change)
+		if err != nil {
+			return nil, err
+		}
+		container[index] = updated
+		return container, nil
+	}
+	return nil, fmt.Errorf("%w: %s does not exist", ErrNotApplicable, token)
+}
+
+// arrayIndex parses an array index token, which may not exceed max
+func arrayIndex(token string, max int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("%w: %q is not an array index", ErrNotApplicable, token)
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 {
+		return 0, fmt.Errorf("%w: %q is not an array index", ErrNotApplicable, token)
+	}
+	if index > max {
+		return 0, fmt.Errorf("%w: index %d is out of range", ErrNotApplicable, index)
+	}
+	return index, nil
+}
+
+// equal compares JSON values as RFC 6902 defines for test: numbers by value
+// and objects regardless of member order
+func equal(a, b any) bool {
+	if x, ok := a.(json.Number); ok {
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		xf, errX := x.Float64()
+		yf, errY := y.Float64()
+		return errX == nil && errY == nil && xf == yf
+	}
+
+	switch x := a.(type) {
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for name, value := range x {
+			other, ok := y[name]
+			if !ok || !equal(value, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		y, ok := b.([]any)
+		return ok && slices.EqualFunc(x, y, equal)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(v))
+		for name, member := range v {
+			copied[name] = deepCopy(member)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(v))
+		for i, item := range v {
+			copied[i] = deepCopy(item)
+		}
+		return copied
+	}
+	return value
+}
@@ -0,0 +1,79 @@
+// Package patch applies JSON Merge Patch (RFC 7396) and JSON Patch
+// (RFC 6902) documents to JSON values. Patches are applied to a decoded copy
+// of the target, so a patch that fails part way leaves nothing changed.
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Media types of the supported patch formats
+const (
+	MergePatchType = "application/merge-patch+json"
+	JSONPatchType  = "application/json-patch+json"
+)
+
+var (
+	// ErrInvalidPatch reports a patch document that is malformed
+	ErrInvalidPatch = errors.New("invalid patch")
+	// ErrTestFailed reports a JSON Patch test operation that did not match
+	ErrTestFailed = errors.New("test failed")
+	// ErrNotApplicable reports an operation whose path cannot be resolved
+	// against the target document
+	ErrNotApplicable = errors.New("patch cannot be applied")
+)
+
+// MergePatch applies an RFC 7396 merge patch to a JSON document. Objects are
+// merged recursively, null removes a member and anything else replaces the
+// target value.
+func MergePatch(document, patch []byte) ([]byte, error) {
+	target, err := decode(document)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := decode(patch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+
+	return json.Marshal(mergeValue(target, changes))
+}
+
+func mergeValue(target, patch any) any {
+	changes, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	merged, ok := target.(map[string]any)
+	if !ok {
+		merged = map[string]any{}
+	}
+	for name, value := range changes {
+		if value == nil {
+			delete(merged, name)
+		} else {
+			merged[name] = mergeValue(merged[name], value)
+		}
+	}
+	return merged
+}
+
+// decode parses a JSON value, keeping numbers as written so that values the
+// patch does not touch come out unchanged
+func decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+	return value, nil
+}
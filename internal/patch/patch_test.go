@@ -0,0 +1,132 @@
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func assertJSON(t *testing.T, got []byte, want string) {
+	t.Helper()
+	var g, w any
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("result is not JSON: %v", err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatalf("expectation is not JSON: %v", err)
+	}
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	// Examples from RFC 7396 appendix A
+	cases := []struct{ target, patch, want string }{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, c := range cases {
+		got, err := MergePatch([]byte(c.target), []byte(c.patch))
+		if err != nil {
+			t.Errorf("%s + %s: unexpected error %v", c.target, c.patch, err)
+			continue
+		}
+		assertJSON(t, got, c.want)
+	}
+}
+
+func TestJSONPatch(t *testing.T) {
+	cases := []struct{ target, patch, want string }{
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`, `{"foo":"bar","baz":"qux"}`},
+		{`{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`, `{"foo":["bar","qux","baz"]}`},
+		{`{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":"baz"}]`, `{"foo":["bar","baz"]}`},
+		{`{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`},
+		{`{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`, `{"foo":["bar","baz"]}`},
+		{`{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`, `{"baz":"boo","foo":"bar"}`},
+		{`{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			`[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			`{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`},
+		{`{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`, `{"foo":["all","cows","eat","grass"]}`},
+		{`{"a":{"b":1}}`, `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`, `{"a":{"b":1},"c":{"b":2}}`},
+		{`{"a/b":1,"m~n":2}`, `[{"op":"test","path":"/a~1b","value":1},{"op":"remove","path":"/m~0n"}]`, `{"a/b":1}`},
+		{`{"n":1}`, `[{"op":"test","path":"/n","value":1.0}]`, `{"n":1}`},
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`, `{"foo":"bar","child":{"grandchild":{}}}`},
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/n","value":null}]`, `{"foo":"bar","n":null}`},
+	}
+
+	for _, c := range cases {
+		patch, err := ParseJSONPatch([]byte(c.patch))
+		if err != nil {
+			t.Errorf("%s: unexpected parse error %v", c.patch, err)
+			continue
+		}
+		got, err := patch.Apply([]byte(c.target))
+		if err != nil {
+			t.Errorf("%s + %s: unexpected error %v", c.target, c.patch, err)
+			continue
+		}
+		assertJSON(t, got, c.want)
+	}
+}
+
+func TestJSONPatch_Errors(t *testing.T) {
+	cases := []struct {
+		target, patch string
+		want          error
+	}{
+		{`{}`, `{"op":"add"}`, ErrInvalidPatch},
+		{`{}`, `[{"op":"jump","path":"/a"}]`, ErrInvalidPatch},
+		{`{}`, `[{"op":"add","path":"/a"}]`, ErrInvalidPatch},
+		{`{}`, `[{"op":"move","path":"/a"}]`, ErrInvalidPatch},
+		{`{}`, `[{"op":"add","path":"a","value":1}]`, ErrInvalidPatch},
+		{`{"baz":"qux"}`, `[{"op":"test","path":"/baz","value":"bar"}]`, ErrTestFailed},
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/baz/bat","value":"qux"}]`, ErrNotApplicable},
+		{`{"foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, ErrNotApplicable},
+		{`{"foo":["bar"]}`, `[{"op":"add","path":"/foo/2","value":"x"}]`, ErrNotApplicable},
+		{`{"foo":["bar"]}`, `[{"op":"add","path":"/foo/01","value":"x"}]`, ErrNotApplicable},
+		{`{"a":{"b":1}}`, `[{"op":"move","from":"/a","path":"/a/c"}]`, ErrNotApplicable},
+	}
+
+	for _, c := range cases {
+		patch, err := ParseJSONPatch([]byte(c.patch))
+		if err == nil {
+			_, err = patch.Apply([]byte(c.target))
+		}
+		if !errors.Is(err, c.want) {
+			t.Errorf("%s + %s: expected %v, got %v", c.target, c.patch, c.want, err)
+		}
+	}
+}
+
+// TestJSONPatch_FailedTestChangesNothing checks that the operations before a
+// failing one do not leak into the result
+func TestJSONPatch_FailedTestChangesNothing(t *testing.T) {
+	document := []byte(`{"status":"new","config":{"a":1}}`)
+	patch, err := ParseJSONPatch([]byte(`[
+		{"op":"replace","path":"/status","value":"in-review"},
+		{"op":"remove","path":"/config/a"},
+		{"op":"test","path":"/status","value":"approved"}
+	]`))
+	if err != nil {
+		t.Fatalf("unexpected parse error %v", err)
+	}
+
+	if result, err := patch.Apply(document); !errors.Is(err, ErrTestFailed) || result != nil {
+		t.Fatalf("expected a failed test and no result, got %s, %v", result, err)
+	}
+	assertJSON(t, document, `{"status":"new","config":{"a":1}}`)
+}
@@ -0,0 +1,305 @@
+// Package protoconv maps catalog records onto the protobuf messages of the
+// gRPC and Connect API, and back. The messages mirror the JSON records, so
+// the mapping is field for field; zero times and empty JSON objects are
+// left unset.
+package protoconv
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bear-belly/mcp-registry/internal/events"
+	registryv1 "github.com/bear-belly/mcp-registry/internal/gen/registry/v1"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// FromServer maps a catalog record onto its message. It fails only when
+// config or a tool's input schema holds a value JSON cannot represent.
+func FromServer(server models.Server) (*registryv1.Server, error) {
+	config, err := fromObject(server.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &registryv1.Server{
+		Id:              server.ID,
+		Slug:            server.Slug,
+		PreviousSlugs:   server.PreviousSlugs,
+		Name:            server.Name,
+		Description:     server.Description,
+		Version:         server.Version,
+		Transport:       server.Transport,
+		Status:          server.Status,
+		CreatedAt:       fromTime(server.CreatedAt),
+		UpdatedAt:       fromTime(server.UpdatedAt),
+		Url:             server.URL,
+		License:         server.License,
+		Tags:            server.Tags,
+		Categories:      server.Categories,
+		Config:          config,
+		LicenseDecision: server.LicenseDecision,
+	}
+
+	if review := server.LicenseReview; review != nil {
+		message.LicenseReview = &registryv1.LicenseReview{
+			License:    review.License,
+			Reviewer:   review.Reviewer,
+			ReviewedAt: fromTime(review.ReviewedAt),
+			Note:       review.Note,
+		}
+	}
+	if ownership := server.Ownership; ownership != nil {
+		message.Ownership = &registryv1.Ownership{
+			Team:              ownership.Team,
+			TechnicalContact:  fromContact(ownership.TechnicalContact),
+			BusinessContact:   fromContact(ownership.BusinessContact),
+			SupportTier:       ownership.SupportTier,
+			EscalationChannel: ownership.EscalationChannel,
+		}
+		if vendor := ownership.Vendor; vendor != nil {
+			message.Ownership.Vendor = &registryv1.Vendor{Name: vendor.Name, Type: vendor.Type}
+		}
+		for _, link := range ownership.Documentation {
+			message.Ownership.Documentation = append(message.Ownership.Documentation, &registryv1.Link{Title: link.Title, Url: link.URL})
+		}
+	}
+	if risk := server.Risk; risk != nil {
+		message.Risk = &registryv1.Risk{
+			DataClassifications: risk.DataClassifications,
+			CanWrite:            risk.CanWrite,
+			CanDelete:           risk.CanDelete,
+			NetworkEgress:       risk.NetworkEgress,
+			Authentication:      risk.Authentication,
+			Hosting:             risk.Hosting,
+		}
+	}
+	if assessment := server.RiskAssessment; assessment != nil {
+		message.RiskAssessment = &registryv1.RiskAssessment{Score: int32(assessment.Score), Level: assessment.Level}
+	}
+
+	for _, relationship := range server.Relationships {
+		message.Relationships = append(message.Relationships, &registryv1.Relationship{
+			Type: relationship.Type, Target: relationship.Target, Note: relationship.Note,
+		})
+	}
+	for _, input := range server.Inputs {
+		message.Inputs = append(message.Inputs, &registryv1.Input{
+			Name: input.Name, Description: input.Description, Required: input.Required,
+			Secret: input.Secret, Default: input.Default, Pattern: input.Pattern,
+		})
+	}
+	for _, attachment := range server.Attachments {
+		message.Attachments = append(message.Attachments, &registryv1.Attachment{
+			Id: attachment.ID, Name: attachment.Name, Kind: attachment.Kind, ContentType: attachment.ContentType,
+			Size: attachment.Size, Sha256: attachment.SHA256, UploadedAt: fromTime(attachment.UploadedAt),
+		})
+	}
+
+	for _, tool := range server.Tools {
+		inputSchema, err := fromObject(tool.InputSchema)
+		if err != nil {
+			return nil, err
+		}
+		converted := &registryv1.Tool{Name: tool.Name, Title: tool.Title, Description: tool.Description, InputSchema: inputSchema}
+		if annotations := tool.Annotations; annotations != nil {
+			converted.Annotations = &registryv1.ToolAnnotations{
+				Title:           annotations.Title,
+				ReadOnlyHint:    annotations.ReadOnlyHint,
+				DestructiveHint: annotations.DestructiveHint,
+				IdempotentHint:  annotations.IdempotentHint,
+				OpenWorldHint:   annotations.OpenWorldHint,
+			}
+		}
+		message.Tools = append(message.Tools, converted)
+	}
+	for _, prompt := range server.Prompts {
+		converted := &registryv1.Prompt{Name: prompt.Name, Title: prompt.Title, Description: prompt.Description}
+		for _, argument := range prompt.Arguments {
+			converted.Arguments = append(converted.Arguments, &registryv1.PromptArgument{
+				Name: argument.Name, Description: argument.Description, Required: argument.Required,
+			})
+		}
+		message.Prompts = append(message.Prompts, converted)
+	}
+	for _, template := range server.ResourceTemplates {
+		message.ResourceTemplates = append(message.ResourceTemplates, &registryv1.ResourceTemplate{
+			UriTemplate: template.URITemplate, Name: template.Name, Title: template.Title,
+			Description: template.Description, MimeType: template.MimeType,
+		})
+	}
+
+	return message, nil
+}
+
+// ToServer maps a message onto a catalog record. The computed fields are
+// left out, as they are on JSON writes.
+func ToServer(message *registryv1.Server) models.Server {
+	server := models.Server{
+		ID:            message.GetId(),
+		Slug:          message.GetSlug(),
+		PreviousSlugs: message.GetPreviousSlugs(),
+		Name:          message.GetName(),
+		Description:   message.GetDescription(),
+		Version:       message.GetVersion(),
+		Transport:     message.GetTransport(),
+		Status:        message.GetStatus(),
+		CreatedAt:     toTime(message.GetCreatedAt()),
+		UpdatedAt:     toTime(message.GetUpdatedAt()),
+		URL:           message.GetUrl(),
+		License:       message.GetLicense(),
+		Tags:          message.GetTags(),
+		Categories:    message.GetCategories(),
+		Config:        toObject(message.GetConfig()),
+	}
+
+	if review := message.GetLicenseReview(); review != nil {
+		server.LicenseReview = &models.LicenseReview{
+			License:    review.GetLicense(),
+			Reviewer:   review.GetReviewer(),
+			ReviewedAt: toTime(review.GetReviewedAt()),
+			Note:       review.GetNote(),
+		}
+	}
+	if ownership := message.GetOwnership(); ownership != nil {
+		server.Ownership = &models.Ownership{
+			Team:              ownership.GetTeam(),
+			TechnicalContact:  toContact(ownership.GetTechnicalContact()),
+			BusinessContact:   toContact(ownership.GetBusinessContact()),
+			SupportTier:       ownership.GetSupportTier(),
+			EscalationChannel: ownership.GetEscalationChannel(),
+		}
+		if vendor := ownership.GetVendor(); vendor != nil {
+			server.Ownership.Vendor = &models.Vendor{Name: vendor.GetName(), Type: vendor.GetType()}
+		}
+		for _, link := range ownership.GetDocumentation() {
+			server.Ownership.Documentation = append(server.Ownership.Documentation, models.Link{Title: link.GetTitle(), URL: link.GetUrl()})
+		}
+	}
+	if risk := message.GetRisk(); risk != nil {
+		server.Risk = &models.Risk{
+			DataClassifications: risk.GetDataClassifications(),
+			CanWrite:            risk.GetCanWrite(),
+			CanDelete:           risk.GetCanDelete(),
+			NetworkEgress:       risk.GetNetworkEgress(),
+			Authentication:      risk.GetAuthentication(),
+			Hosting:             risk.GetHosting(),
+		}
+	}
+
+	for _, relationship := range message.GetRelationships() {
+		server.Relationships = append(server.Relationships, models.Relationship{
+			Type: relationship.GetType(), Target: relationship.GetTarget(), Note: relationship.GetNote(),
+		})
+	}
+	for _, input := range message.GetInputs() {
+		server.Inputs = append(server.Inputs, models.Input{
+			Name: input.GetName(), Description: input.GetDescription(), Required: input.GetRequired(),
+			Secret: input.GetSecret(), Default: input.GetDefault(), Pattern: input.GetPattern(),
+		})
+	}
+	for _, attachment := range message.GetAttachments() {
+		server.Attachments = append(server.Attachments, models.Attachment{
+			ID: attachment.GetId(), Name: attachment.GetName(), Kind: attachment.GetKind(), ContentType: attachment.GetContentType(),
+			Size: attachment.GetSize(), SHA256: attachment.GetSha256(), UploadedAt: toTime(attachment.GetUploadedAt()),
+		})
+	}
+
+	for _, tool := range message.GetTools() {
+		converted := models.Tool{
+			Name: tool.GetName(), Title: tool.GetTitle(), Description: tool.GetDescription(),
+			InputSchema: toObject(tool.GetInputSchema()),
+		}
+		if annotations := tool.GetAnnotations(); annotations != nil {
+			converted.Annotations = &models.ToolAnnotations{
+				Title:           annotations.GetTitle(),
+				ReadOnlyHint:    annotations.ReadOnlyHint,
+				DestructiveHint: annotations.DestructiveHint,
+				IdempotentHint:  annotations.IdempotentHint,
+				OpenWorldHint:   annotations.OpenWorldHint,
+			}
+		}
+		server.Tools = append(server.Tools, converted)
+	}
+	for _, prompt := range message.GetPrompts() {
+		converted := models.Prompt{Name: prompt.GetName(), Title: prompt.GetTitle(), Description: prompt.GetDescription()}
+		for _, argument := range prompt.GetArguments() {
+			converted.Arguments = append(converted.Arguments, models.PromptArgument{
+				Name: argument.GetName(), Description: argument.GetDescription(), Required: argument.GetRequired(),
+			})
+		}
+		server.Prompts = append(server.Prompts, converted)
+	}
+	for _, template := range message.GetResourceTemplates() {
+		server.ResourceTemplates = append(server.ResourceTemplates, models.ResourceTemplate{
+			URITemplate: template.GetUriTemplate(), Name: template.GetName(), Title: template.GetTitle(),
+			Description: template.GetDescription(), MimeType: template.GetMimeType(),
+		})
+	}
+
+	return server
+}
+
+// FromEvent maps a catalog event onto its message
+func FromEvent(event events.Event) (*registryv1.ServerEvent, error) {
+	message := &registryv1.ServerEvent{
+		Id:             event.ID,
+		Type:           event.Type,
+		Time:           fromTime(event.Time),
+		ServerId:       event.Data.ID,
+		Slug:           event.Data.Slug,
+		Status:         event.Data.Status,
+		PreviousStatus: event.Data.PreviousStatus,
+	}
+	if event.Data.Server != nil {
+		server, err := FromServer(*event.Data.Server)
+		if err != nil {
+			return nil, err
+		}
+		message.Server = server
+	}
+	return message, nil
+}
+
+func fromTime(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func toTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+func fromObject(object map[string]interface{}) (*structpb.Struct, error) {
+	if object == nil {
+		return nil, nil
+	}
+	return structpb.NewStruct(object)
+}
+
+func toObject(object *structpb.Struct) map[string]interface{} {
+	if object == nil {
+		return nil
+	}
+	return object.AsMap()
+}
+
+func fromContact(contact *models.Contact) *registryv1.Contact {
+	if contact == nil {
+		return nil
+	}
+	return &registryv1.Contact{Name: contact.Name, Email: contact.Email}
+}
+
+func toContact(contact *registryv1.Contact) *models.Contact {
+	if contact == nil {
+		return nil
+	}
+	return &models.Contact{Name: contact.GetName(), Email: contact.GetEmail()}
+}
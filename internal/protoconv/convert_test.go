@@ -0,0 +1,73 @@
+package protoconv
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func TestServer_RoundTrips(t *testing.T) {
+	readOnly := true
+	now := time.Date(2025, 7, 16, 12, 34, 56, 0, time.UTC)
+	server := models.Server{
+		ID:            "5f1c2d9e",
+		Slug:          "github",
+		PreviousSlugs: []string{"gh"},
+		Name:          "GitHub",
+		Description:   "Repositories and issues",
+		Version:       "1.2.0",
+		Transport:     models.TransportStdio,
+		Status:        models.StatusApproved,
+		CreatedAt:     now,
+		UpdatedAt:     now.Add(time.Hour),
+		URL:           "https://github.com/github/github-mcp-server",
+		License:       "MIT",
+		LicenseReview: &models.LicenseReview{License: "MIT", Reviewer: "legal", ReviewedAt: now, Note: "ok"},
+		Tags:          []string{"source-control"},
+		Categories:    []string{"developer-tools"},
+		Ownership: &models.Ownership{
+			Team:             "Developer Experience",
+			TechnicalContact: &models.Contact{Name: "Dev", Email: "dev@example.com"},
+			Vendor:           &models.Vendor{Name: "GitHub", Type: models.VendorThirdParty},
+			SupportTier:      models.SupportTierStandard,
+			Documentation:    []models.Link{{Title: "Docs", URL: "https://docs.example.com"}},
+		},
+		Risk:          &models.Risk{DataClassifications: []string{models.DataInternal}, CanWrite: true, Hosting: models.HostingLocal},
+		Relationships: []models.Relationship{{Type: models.RelationReplaces, Target: "old"}},
+		Inputs:        []models.Input{{Name: "token", Required: true, Secret: true}},
+		Attachments:   []models.Attachment{{ID: "a1", Name: "review.pdf", Size: 42, SHA256: "abc", UploadedAt: now}},
+		Config: map[string]interface{}{
+			"github": map[string]interface{}{"command": "npx", "args": []interface{}{"-y", "server"}},
+		},
+		Tools: []models.Tool{{
+			Name:        "get_file",
+			InputSchema: map[string]interface{}{"type": "object", "maxProperties": float64(3)},
+			Annotations: &models.ToolAnnotations{ReadOnlyHint: &readOnly},
+		}},
+		Prompts:           []models.Prompt{{Name: "review", Arguments: []models.PromptArgument{{Name: "pr", Required: true}}}},
+		ResourceTemplates: []models.ResourceTemplate{{URITemplate: "repo://{owner}/{name}", Name: "repo"}},
+	}
+
+	message, err := FromServer(server)
+	if err != nil {
+		t.Fatalf("converting server: %v", err)
+	}
+	if got := ToServer(message); !reflect.DeepEqual(got, server) {
+		t.Errorf("round trip changed the server:\n got %+v\nwant %+v", got, server)
+	}
+}
+
+func TestFromServer_LeavesZeroTimesUnset(t *testing.T) {
+	message, err := FromServer(models.Server{Name: "Draft"})
+	if err != nil {
+		t.Fatalf("converting server: %v", err)
+	}
+	if message.CreatedAt != nil || message.Config != nil {
+		t.Errorf("expected zero values to stay unset, got %v", message)
+	}
+	if got := ToServer(message); !got.CreatedAt.IsZero() || got.Config != nil {
+		t.Errorf("expected zero values back, got %+v", got)
+	}
+}
@@ -0,0 +1,142 @@
+package risk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// maxScore caps the risk score so that scores stay comparable when weights change
+const maxScore = 100
+
+// DefaultWeights returns the weights used when no weights file is configured
+func DefaultWeights() models.RiskWeights {
+	return models.RiskWeights{
+		DataClassifications: map[string]int{
+			models.DataPublic:       0,
+			models.DataInternal:     10,
+			models.DataConfidential: 25,
+			models.DataRestricted:   40,
+		},
+		Write:  15,
+		Delete: 20,
+		NetworkEgress: map[string]int{
+			models.EgressNone:     0,
+			models.EgressInternal: 5,
+			models.EgressInternet: 15,
+		},
+		Authentication: map[string]int{
+			models.AuthSSO:    0,
+			models.AuthOAuth:  0,
+			models.AuthAPIKey: 5,
+			models.AuthNone:   15,
+		},
+		Hosting: map[string]int{
+			models.HostingLocal:    0,
+			models.HostingInternal: 5,
+			models.HostingVendor:   15,
+		},
+		Unknown: 10,
+		Levels: []models.RiskLevel{
+			{Name: models.RiskLow, MinScore: 0},
+			{Name: models.RiskMedium, MinScore: 25},
+			{Name: models.RiskHigh, MinScore: 50},
+			{Name: models.RiskCritical, MinScore: 75},
+		},
+	}
+}
+
+// LoadWeights reads risk weights from a JSON file. An empty path selects the
+// default weights.
+func LoadWeights(path string) (models.RiskWeights, error) {
+	if path == "" {
+		return DefaultWeights(), nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return models.RiskWeights{}, fmt.Errorf("reading risk weights: %w", err)
+	}
+
+	var weights models.RiskWeights
+	if err := json.Unmarshal(content, &weights); err != nil {
+		return models.RiskWeights{}, fmt.Errorf("parsing risk weights: %w", err)
+	}
+	if len(weights.Levels) == 0 {
+		return models.RiskWeights{}, fmt.Errorf("risk weights must define at least one level")
+	}
+
+	return weights, nil
+}
+
+// Assess computes the risk score and level of a server. Servers without any
+// risk metadata score Unknown for every factor.
+func Assess(risk *models.Risk, weights models.RiskWeights) models.RiskAssessment {
+	if risk == nil {
+		risk = &models.Risk{}
+	}
+
+	score := 0
+
+	// The most sensitive classification the server touches decides the score
+	if len(risk.DataClassifications) == 0 {
+		score += weights.Unknown
+	} else {
+		highest := 0
+		for _, classification := range risk.DataClassifications {
+			highest = max(highest, lookup(weights.DataClassifications, classification, weights.Unknown))
+		}
+		score += highest
+	}
+
+	if risk.CanWrite {
+		score += weights.Write
+	}
+	if risk.CanDelete {
+		score += weights.Delete
+	}
+
+	score += lookup(weights.NetworkEgress, risk.NetworkEgress, weights.Unknown)
+	score += lookup(weights.Authentication, risk.Authentication, weights.Unknown)
+	score += lookup(weights.Hosting, risk.Hosting, weights.Unknown)
+
+	score = min(score, maxScore)
+
+	return models.RiskAssessment{Score: score, Level: level(score, weights.Levels)}
+}
+
+// Levels returns the configured level names from lowest to highest
+func Levels(weights models.RiskWeights) []string {
+	levels := sortedLevels(weights.Levels)
+	names := make([]string, len(levels))
+	for i, level := range levels {
+		names[i] = level.Name
+	}
+	return names
+}
+
+func lookup(weights map[string]int, value string, unknown int) int {
+	if weight, ok := weights[value]; ok && value != "" {
+		return weight
+	}
+	return unknown
+}
+
+func level(score int, levels []models.RiskLevel) string {
+	name := ""
+	for _, level := range sortedLevels(levels) {
+		if score >= level.MinScore {
+			name = level.Name
+		}
+	}
+	return name
+}
+
+func sortedLevels(levels []models.RiskLevel) []models.RiskLevel {
+	sorted := append([]models.RiskLevel(nil), levels...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].MinScore < sorted[j].MinScore })
+	return sorted
+}
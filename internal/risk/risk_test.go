@@ -0,0 +1,67 @@
+package risk
+
+import (
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func TestAssess_LocalReadOnly(t *testing.T) {
+	assessment := Assess(&models.Risk{
+		DataClassifications: []string{models.DataPublic},
+		NetworkEgress:       models.EgressNone,
+		Authentication:      models.AuthOAuth,
+		Hosting:             models.HostingLocal,
+	}, DefaultWeights())
+
+	if assessment.Score != 0 {
+		t.Errorf("expected score 0, got %d", assessment.Score)
+	}
+	if assessment.Level != models.RiskLow {
+		t.Errorf("expected level %q, got %q", models.RiskLow, assessment.Level)
+	}
+}
+
+func TestAssess_MostSensitiveClassificationWins(t *testing.T) {
+	weights := DefaultWeights()
+	assessment := Assess(&models.Risk{
+		DataClassifications: []string{models.DataPublic, models.DataRestricted, models.DataInternal},
+		NetworkEgress:       models.EgressNone,
+		Authentication:      models.AuthOAuth,
+		Hosting:             models.HostingLocal,
+	}, weights)
+
+	if want := weights.DataClassifications[models.DataRestricted]; assessment.Score != want {
+		t.Errorf("expected score %d, got %d", want, assessment.Score)
+	}
+}
+
+func TestAssess_MissingMetadataIsNotSafe(t *testing.T) {
+	weights := DefaultWeights()
+	assessment := Assess(nil, weights)
+
+	if want := 4 * weights.Unknown; assessment.Score != want {
+		t.Errorf("expected score %d, got %d", want, assessment.Score)
+	}
+	if assessment.Level != models.RiskMedium {
+		t.Errorf("expected level %q, got %q", models.RiskMedium, assessment.Level)
+	}
+}
+
+func TestAssess_ScoreIsCapped(t *testing.T) {
+	assessment := Assess(&models.Risk{
+		DataClassifications: []string{models.DataRestricted},
+		CanWrite:            true,
+		CanDelete:           true,
+		NetworkEgress:       models.EgressInternet,
+		Authentication:      models.AuthNone,
+		Hosting:             models.HostingVendor,
+	}, DefaultWeights())
+
+	if assessment.Score != maxScore {
+		t.Errorf("expected score %d, got %d", maxScore, assessment.Score)
+	}
+	if assessment.Level != models.RiskCritical {
+		t.Errorf("expected level %q, got %q", models.RiskCritical, assessment.Level)
+	}
+}
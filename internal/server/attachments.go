@@ -0,0 +1,212 @@
+package server
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/blob"
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/logger"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func (s *Server) setupAttachmentRoutes() {
+	s.handleServerAPI("GET /{server}/attachments", s.ListAttachments)
+	s.handleServerAdminAPI("POST /{server}/attachments", s.UploadAttachment)
+	s.handleServerAPI("GET /{server}/attachments/{attachment}", s.DownloadAttachment)
+	s.handleServerAdminAPI("DELETE /{server}/attachments/{attachment}", s.DeleteAttachment)
+}
+
+// ListAttachments returns the attachment records of a server
+func (s *Server) ListAttachments(w http.ResponseWriter, r *http.Request) {
+	server, ok := s.serverFromRequest(w, r, http.StatusPermanentRedirect)
+	if !ok {
+		return
+	}
+
+	attachments := server.Attachments
+	if attachments == nil {
+		attachments = []models.Attachment{}
+	}
+	writeJSON(w, http.StatusOK, attachments)
+}
+
+// UploadAttachment attaches a file to a server. The request is a multipart
+// form with a "file" part, a "kind" field and an optional "name" field that
+// defaults to the uploaded file name.
+func (s *Server) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	server, ok := s.serverFromRequest(w, r, http.StatusPermanentRedirect)
+	if !ok {
+		return
+	}
+
+	// Leave room for the form fields and multipart framing around the file
+	r.Body = http.MaxBytesReader(w, r.Body, s.config.MaxAttachmentSize+maxRequestBodyBytes)
+	if err := r.ParseMultipartForm(maxRequestBodyBytes); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if stderrors.As(err, &maxBytesErr) {
+			errors.WriteError(w, errors.NewBadRequestError(fmt.Sprintf("Attachment exceeds %d bytes", s.config.MaxAttachmentSize)).
+				SetStatusCode(http.StatusRequestEntityTooLarge))
+			return
+		}
+		errors.WriteError(w, errors.NewBadRequestError("Invalid multipart form: "+err.Error()))
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	kind := r.FormValue("kind")
+	if !slices.Contains(models.AttachmentKinds, kind) {
+		errors.WriteError(w, errors.NewBadRequestError("kind must be one of "+strings.Join(models.AttachmentKinds, ", ")))
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		errors.WriteError(w, errors.NewBadRequestError("The form must contain a file part named \"file\""))
+		return
+	}
+	defer file.Close()
+
+	name := strings.TrimSpace(r.FormValue("name"))
+	if name == "" {
+		name = filepath.Base(header.Filename)
+	}
+
+	info, err := blob.Ingest(ctx, s.blobs, file, s.config.MaxAttachmentSize)
+	if err != nil {
+		writeStorageError(w, "Failed to store attachment", err)
+		return
+	}
+
+	attachment := models.Attachment{
+		ID:          models.NewID(),
+		Name:        name,
+		Kind:        kind,
+		ContentType: info.ContentType,
+		Size:        info.Size,
+		SHA256:      info.Key,
+		UploadedAt:  time.Now().UTC(),
+	}
+
+	// The upload can take a while, so the record is read again under the
+	// server's lock to keep changes made in the meantime
+	server, unlock, err := s.lockedServer(ctx, server.ID)
+	if err != nil {
+		s.releaseBlob(ctx, info.Key)
+		writeStorageError(w, "Failed to retrieve server", err)
+		return
+	}
+	defer unlock()
+
+	server.Attachments = append(server.Attachments, attachment)
+	if _, err := s.storage.UpdateServer(ctx, server); err != nil {
+		s.releaseBlob(ctx, info.Key)
+		writeStorageError(w, "Failed to save attachment", err)
+		return
+	}
+
+	w.Header().Set("Location", attachmentPath(apiVersionFrom(r.Context()), server, attachment))
+	writeJSON(w, http.StatusCreated, attachment)
+}
+
+// DownloadAttachment serves the content of an attachment. Content is always
+// offered as a download with the sniffed type so that browsers never render
+// uploaded files inline.
+func (s *Server) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	server, ok := s.serverFromRequest(w, r, http.StatusPermanentRedirect)
+	if !ok {
+		return
+	}
+
+	attachment, found := findAttachment(server, r.PathValue("attachment"))
+	if !found {
+		errors.WriteError(w, errors.NewNotFoundError("Attachment"))
+		return
+	}
+
+	content, err := s.blobs.Open(r.Context(), attachment.SHA256)
+	if err != nil {
+		writeStorageError(w, "Failed to read attachment", err)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("ETag", `"`+attachment.SHA256+`"`)
+
+	if _, err := io.Copy(w, content); err != nil {
+		logger.Error("Failed to send attachment", "error", err)
+	}
+}
+
+// DeleteAttachment removes an attachment from a server
+func (s *Server) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	server, unlock, ok := s.lockServerFromRequest(w, r)
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	attachment, found := findAttachment(server, r.PathValue("attachment"))
+	if !found {
+		errors.WriteError(w, errors.NewNotFoundError("Attachment"))
+		return
+	}
+
+	server.Attachments = slices.DeleteFunc(server.Attachments, func(a models.Attachment) bool { return a.ID == attachment.ID })
+	if _, err := s.storage.UpdateServer(r.Context(), server); err != nil {
+		writeStorageError(w, "Failed to delete attachment", err)
+		return
+	}
+
+	s.releaseBlob(r.Context(), attachment.SHA256)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// releaseBlob deletes stored content once no attachment refers to it any
+// more. Identical files share a blob, possibly across servers.
+func (s *Server) releaseBlob(ctx context.Context, key string) {
+	servers, err := s.storage.ListServers(ctx)
+	if err != nil {
+		logger.Error("Failed to check attachment references", "error", err)
+		return
+	}
+
+	for _, server := range servers {
+		for _, attachment := range server.Attachments {
+			if attachment.SHA256 == key {
+				return
+			}
+		}
+	}
+
+	if err := s.blobs.Delete(ctx, key); err != nil && !isNotFound(err) {
+		logger.Error("Failed to delete attachment content", "error", err)
+	}
+}
+
+func findAttachment(server models.Server, id string) (models.Attachment, bool) {
+	for _, attachment := range server.Attachments {
+		if attachment.ID == id {
+			return attachment, true
+		}
+	}
+	return models.Attachment{}, false
+}
+
+func attachmentPath(version *apiVersion, server models.Server, attachment models.Attachment) string {
+	return version.serverPath(server) + "/attachments/" + attachment.ID
+}
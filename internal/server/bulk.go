@@ -0,0 +1,257 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/logger"
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/storage"
+)
+
+// ndjsonContentType is the media type of newline-delimited JSON
+const ndjsonContentType = "application/x-ndjson"
+
+// Conflict modes of an import, deciding what happens to a line whose server
+// already exists
+const (
+	conflictFail   = "fail"
+	conflictSkip   = "skip"
+	conflictUpsert = "upsert"
+)
+
+var conflictModes = []string{conflictFail, conflictSkip, conflictUpsert}
+
+// Results of an import line
+const (
+	importCreated = "created"
+	importUpdated = "updated"
+	importSkipped = "skipped"
+	importFailed  = "failed"
+)
+
+// importResult reports on one line of an import. The last line of the report
+// carries the summary instead.
+type importResult struct {
+	Line    int                   `json:"line,omitempty"`
+	Result  string                `json:"result,omitempty"`
+	ID      string                `json:"id,omitempty"`
+	Slug    string                `json:"slug,omitempty"`
+	Error   *errors.ErrorResponse `json:"error,omitempty"`
+	Summary *importSummary        `json:"summary,omitempty"`
+}
+
+// importSummary counts the results of an import. Stopped is set when the
+// import ended early, at a conflict in fail mode or at an unreadable line.
+type importSummary struct {
+	Created int  `json:"created"`
+	Updated int  `json:"updated"`
+	Skipped int  `json:"skipped"`
+	Failed  int  `json:"failed"`
+	DryRun  bool `json:"dryRun"`
+	Stopped bool `json:"stopped"`
+}
+
+func (s *Server) setupBulkRoutes() {
+	s.handleServerAPI("GET :export", s.ExportServers)
+	s.handleServerAdminAPI("POST :import", s.ImportServers)
+}
+
+// ExportServers streams every server as one JSON object per line, in the
+// representation of the API version, which its import accepts
+func (s *Server) ExportServers(w http.ResponseWriter, r *http.Request) {
+	codec := apiVersionFrom(r.Context()).codec
+
+	w.Header().Set("Content-Type", ndjsonContentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="servers.ndjson"`)
+
+	encoder := json.NewEncoder(w)
+	err := s.storage.EachServer(r.Context(), func(server models.Server) error {
+		return encoder.Encode(codec.toJSON(server))
+	})
+	if err != nil {
+		// The status line has gone out with the first server, so all that is
+		// left is to cut the stream short
+		logger.Error("Failed to export servers", "error", err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// ImportServers reads servers from a newline-delimited JSON body and
+// creates them, or applies the conflict mode to those that exist, matched by
+// ID or else by the slug of their name. Lines are read and answered one at a
+// time, so neither the body nor the report is held in memory. Lines already
+// imported stay imported when a later one stops the import; a dry run checks
+// each line against the catalog as it is, without the earlier lines.
+func (s *Server) ImportServers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	mode := conflictFail
+	if value := query.Get("conflict"); value != "" {
+		if !slices.Contains(conflictModes, value) {
+			errors.WriteError(w, errors.NewBadRequestError("conflict must be one of "+strings.Join(conflictModes, ", ")))
+			return
+		}
+		mode = value
+	}
+
+	summary := importSummary{}
+	if value := query.Get("dryRun"); value != "" {
+		dryRun, err := strconv.ParseBool(value)
+		if err != nil {
+			errors.WriteError(w, errors.NewBadRequestError("dryRun must be true or false"))
+			return
+		}
+		summary.DryRun = dryRun
+	}
+
+	ctx := r.Context()
+	if summary.DryRun {
+		ctx = storage.WithDryRun(ctx)
+	}
+
+	// The report is written while the body is still being read
+	controller := http.NewResponseController(w)
+	controller.EnableFullDuplex()
+
+	w.Header().Set("Content-Type", ndjsonContentType)
+	w.WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(w)
+
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Buffer(make([]byte, 64*1024), maxRequestBodyBytes)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		result, stop := s.importLine(ctx, apiVersionFrom(r.Context()).codec, data, mode)
+		result.Line = line
+		summary.count(result.Result)
+
+		encoder.Encode(result)
+		controller.Flush()
+
+		if stop {
+			summary.Stopped = true
+			break
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		message := "Failed to read the import body"
+		if stderrors.Is(err, bufio.ErrTooLong) {
+			message = fmt.Sprintf("Line exceeds %d bytes", maxRequestBodyBytes)
+		}
+		encoder.Encode(importResult{Line: line + 1, Result: importFailed, Error: importError(errors.NewBadRequestError(message))})
+		summary.count(importFailed)
+		summary.Stopped = true
+	}
+
+	encoder.Encode(importResult{Summary: &summary})
+}
+
+// importLine imports a single server, represented as the codec does, and
+// reports whether the import has to stop
+func (s *Server) importLine(ctx context.Context, codec serverCodec, data []byte, mode string) (importResult, bool) {
+	server, err := codec.fromJSON(data)
+	if err != nil {
+		return failedImport(errors.NewBadRequestError("Invalid JSON: " + err.Error())), false
+	}
+
+	// Attachments can only be added by uploading them
+	server.Attachments = nil
+
+	existing, found, err := s.findImportTarget(ctx, server)
+	if err != nil {
+		return failedImport(err), false
+	}
+
+	if !found {
+		created, err := s.storage.CreateServer(ctx, server)
+		if err != nil {
+			return failedImport(err), false
+		}
+		return importResult{Result: importCreated, ID: created.ID, Slug: created.Slug}, false
+	}
+
+	switch mode {
+	case conflictSkip:
+		return importResult{Result: importSkipped, ID: existing.ID, Slug: existing.Slug}, false
+	case conflictFail:
+		result := failedImport(errors.NewConflictError(fmt.Sprintf("Server %q already exists", existing.Slug)))
+		result.ID, result.Slug = existing.ID, existing.Slug
+		return result, true
+	}
+
+	server.ID = existing.ID
+	server.Attachments = existing.Attachments
+
+	err = checkTransition(existing.Status, server.Status)
+	updated := existing
+	if err == nil {
+		updated, err = s.storage.UpdateServer(ctx, server)
+	}
+	if err != nil {
+		result := failedImport(err)
+		result.ID, result.Slug = existing.ID, existing.Slug
+		return result, false
+	}
+	return importResult{Result: importUpdated, ID: updated.ID, Slug: updated.Slug}, false
+}
+
+// findImportTarget looks up the server an imported record refers to: by ID
+// when it has one, otherwise by the slug its name would get
+func (s *Server) findImportTarget(ctx context.Context, server models.Server) (models.Server, bool, error) {
+	var existing models.Server
+	var err error
+	if server.ID != "" {
+		existing, err = s.storage.GetServer(ctx, server.ID)
+	} else {
+		existing, err = s.storage.GetServerBySlug(ctx, models.Slugify(server.Name))
+		if err == nil && existing.Slug != models.Slugify(server.Name) {
+			// Only a previous slug matched, which a new server may take over
+			return models.Server{}, false, nil
+		}
+	}
+
+	if isNotFound(err) {
+		return models.Server{}, false, nil
+	}
+	return existing, err == nil, err
+}
+
+func (summary *importSummary) count(result string) {
+	switch result {
+	case importCreated:
+		summary.Created++
+	case importUpdated:
+		summary.Updated++
+	case importSkipped:
+		summary.Skipped++
+	case importFailed:
+		summary.Failed++
+	}
+}
+
+func failedImport(err error) importResult {
+	return importResult{Result: importFailed, Error: importError(err)}
+}
+
+func importError(err error) *errors.ErrorResponse {
+	_, response := errors.ToResponse(err)
+	return &response
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func runImport(t *testing.T, s *Server, query, body string) []importResult {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/servers/v1:import?"+query, strings.NewReader(body)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+	}
+
+	var results []importResult
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		var result importResult
+		if err := json.Unmarshal(scanner.Bytes(), &result); err != nil {
+			t.Fatalf("report line is not JSON: %v", err)
+		}
+		results = append(results, result)
+	}
+	if len(results) == 0 || results[len(results)-1].Summary == nil {
+		t.Fatalf("expected the report to end with a summary, got %+v", results)
+	}
+	return results
+}
+
+const importBody = `{"name":"Alpha","description":"first","transport":"stdio","status":"new"}
+
+{"name":"Beta","description":"second","transport":"stdio","status":"new"}
+{"name":"Alpha","description":"again","transport":"stdio","status":"new"}
+`
+
+func TestImport_ConflictModes(t *testing.T) {
+	s := newTestServer(t)
+
+	results := runImport(t, s, "", importBody)
+	if got := results[2]; got.Line != 4 || got.Result != importFailed || got.Error == nil {
+		t.Errorf("expected line 4 to fail on the duplicate, got %+v", got)
+	}
+	if summary := results[3].Summary; summary.Created != 2 || summary.Failed != 1 || !summary.Stopped {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+
+	results = runImport(t, s, "conflict=skip", importBody)
+	if summary := results[len(results)-1].Summary; summary.Skipped != 3 || summary.Stopped {
+		t.Errorf("expected every line to be skipped, got %+v", summary)
+	}
+
+	results = runImport(t, s, "conflict=upsert", importBody)
+	if summary := results[len(results)-1].Summary; summary.Updated != 3 {
+		t.Errorf("expected every line to update, got %+v", summary)
+	}
+	alpha, err := s.storage.GetServerBySlug(context.Background(), "alpha")
+	if err != nil || alpha.Description != "again" {
+		t.Errorf("expected the last line to win, got %q (%v)", alpha.Description, err)
+	}
+}
+
+func TestImport_DryRunStoresNothing(t *testing.T) {
+	s := newTestServer(t)
+
+	results := runImport(t, s, "dryRun=true", importBody)
+	if summary := results[len(results)-1].Summary; summary.Created != 3 || !summary.DryRun {
+		t.Errorf("expected every line to pass, got %+v", summary)
+	}
+
+	if servers, _ := s.storage.ListServers(context.Background()); len(servers) != 0 {
+		t.Errorf("expected a dry run to store nothing, got %d servers", len(servers))
+	}
+}
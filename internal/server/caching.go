@@ -0,0 +1,242 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCachedBodyBytes bounds the response held back to compute its ETag.
+// Larger responses are sent as they are written, without a validator.
+const maxCachedBodyBytes = 8 << 20
+
+// cachePolicy is the Cache-Control of the routes under a path prefix.
+// Catalog routes render catalog content and carry its Last-Modified.
+type cachePolicy struct {
+	prefix       string
+	cacheControl string
+	catalog      bool
+}
+
+// cachePolicies are matched in order. Everything derived from the catalog
+// is revalidated on every use, which the ETag makes cheap, since an admin
+// change has to show up straight away.
+var cachePolicies = []cachePolicy{
+	{prefix: "/static/", cacheControl: "public, max-age=3600"},
+	{prefix: "/health", cacheControl: "no-store"},
+	{prefix: "/uptime", cacheControl: "no-store"},
+	{prefix: "/api/openapi.json", cacheControl: "public, no-cache"},
+	{prefix: "/api/servers/v1:export", cacheControl: "no-store"},
+	{prefix: "/api/servers/v2:export", cacheControl: "no-store"},
+	{prefix: "/api/events", cacheControl: "no-store"},
+	{prefix: "/api/webhooks/", cacheControl: "private, no-store"},
+	{prefix: "/api/", cacheControl: "public, no-cache", catalog: true},
+	{prefix: "/v0/", cacheControl: "public, no-cache", catalog: true},
+	{prefix: "/server/", cacheControl: "no-cache", catalog: true},
+	{prefix: "/", cacheControl: "no-cache", catalog: true},
+}
+
+func findCachePolicy(path string) cachePolicy {
+	for _, policy := range cachePolicies {
+		if strings.HasPrefix(path, policy.prefix) {
+			return policy
+		}
+	}
+	return cachePolicy{cacheControl: "no-cache"}
+}
+
+// cachingMiddleware gives GET responses a Cache-Control policy and a strong
+// ETag over the body, and answers conditional requests whose validators
+// still match with 304 Not Modified
+func (s *Server) cachingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		policy := findCachePolicy(r.URL.Path)
+		w.Header().Set("Cache-Control", policy.cacheControl)
+
+		cw := &cacheWriter{ResponseWriter: w, request: r}
+		if policy.catalog {
+			cw.lastModified = s.catalogModifiedAt()
+		}
+		next.ServeHTTP(cw, r)
+		cw.finish()
+	})
+}
+
+// catalogModifiedAt is when the catalog last changed. Changes made before
+// this process started cannot be seen, nor can a deploy that changes how
+// pages render, so the clock starts at the start time.
+func (s *Server) catalogModifiedAt() time.Time {
+	if modified := s.catalog.ModifiedAt(); !modified.IsZero() {
+		return modified
+	}
+	return s.startTime
+}
+
+// cacheWriter holds back a 200 response until the handler is done, so that
+// its ETag can be computed and checked against the request. Streamed
+// responses and responses that already carry an ETag pass straight through.
+type cacheWriter struct {
+	http.ResponseWriter
+	request      *http.Request
+	lastModified time.Time
+
+	wroteHeader bool
+	status      int
+	buffering   bool
+	discard     bool
+	body        bytes.Buffer
+}
+
+func (cw *cacheWriter) WriteHeader(status int) {
+	if cw.wroteHeader {
+		return
+	}
+	cw.wroteHeader = true
+	cw.status = status
+
+	header := cw.Header()
+	if status != http.StatusOK {
+		header.Del("Last-Modified")
+		cw.ResponseWriter.WriteHeader(status)
+		return
+	}
+
+	if !cw.lastModified.IsZero() && header.Get("Last-Modified") == "" {
+		header.Set("Last-Modified", cw.lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if header.Get("ETag") != "" {
+		cw.sendHeader()
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	if mediaType == ndjsonContentType || mediaType == eventStreamContentType {
+		cw.ResponseWriter.WriteHeader(status)
+		return
+	}
+
+	cw.buffering = true
+}
+
+// sendHeader answers with 304 when the request's validators match, and
+// otherwise sends the status on to the client
+func (cw *cacheWriter) sendHeader() {
+	if notModified(cw.request, cw.Header()) {
+		cw.discard = true
+		writeNotModified(cw.ResponseWriter)
+		return
+	}
+	cw.ResponseWriter.WriteHeader(cw.status)
+}
+
+func (cw *cacheWriter) Write(p []byte) (int, error) {
+	if !cw.wroteHeader {
+		if cw.Header().Get("Content-Type") == "" {
+			cw.Header().Set("Content-Type", http.DetectContentType(p))
+		}
+		cw.WriteHeader(http.StatusOK)
+	}
+
+	switch {
+	case cw.discard:
+		return len(p), nil
+	case cw.buffering:
+		if cw.body.Len()+len(p) <= maxCachedBodyBytes {
+			return cw.body.Write(p)
+		}
+		cw.release()
+	}
+	return cw.ResponseWriter.Write(p)
+}
+
+// release gives up on the ETag and sends what has been held back
+func (cw *cacheWriter) release() {
+	cw.buffering = false
+	cw.ResponseWriter.WriteHeader(cw.status)
+	cw.ResponseWriter.Write(cw.body.Bytes())
+	cw.body.Reset()
+}
+
+// Flush means the handler is streaming, which rules out an ETag
+func (cw *cacheWriter) Flush() {
+	if cw.buffering {
+		cw.release()
+	}
+	http.NewResponseController(cw.ResponseWriter).Flush()
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (cw *cacheWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+// finish tags the held back body and sends it, or 304 when the client
+// already has it
+func (cw *cacheWriter) finish() {
+	if !cw.buffering {
+		return
+	}
+
+	header := cw.Header()
+	header.Set("ETag", bodyETag(cw.body.Bytes()))
+	if notModified(cw.request, header) {
+		writeNotModified(cw.ResponseWriter)
+		return
+	}
+
+	header.Set("Content-Length", strconv.Itoa(cw.body.Len()))
+	cw.ResponseWriter.WriteHeader(cw.status)
+	cw.ResponseWriter.Write(cw.body.Bytes())
+}
+
+// bodyETag is the strong ETag of a response body
+func bodyETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// notModified evaluates If-None-Match, or failing that If-Modified-Since,
+// against the validators of a response as RFC 9110 lays out for GET
+func notModified(r *http.Request, header http.Header) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		etag := strings.TrimPrefix(header.Get("ETag"), "W/")
+		if etag == "" {
+			return false
+		}
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	modified, err := http.ParseTime(header.Get("Last-Modified"))
+	return err == nil && !modified.After(since)
+}
+
+// writeNotModified drops the headers that describe a body, which a 304 does
+// not have, and keeps the validators and caching headers
+func writeNotModified(w http.ResponseWriter) {
+	header := w.Header()
+	for _, name := range []string{"Content-Type", "Content-Length", "Content-Encoding", "Content-Disposition"} {
+		header.Del(name)
+	}
+	w.WriteHeader(http.StatusNotModified)
+}
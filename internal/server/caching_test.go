@@ -0,0 +1,122 @@
+package server
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func get(s *Server, path string, header map[string]string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, path, nil)
+	for name, value := range header {
+		r.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, r)
+	return rec
+}
+
+func TestCaching_ConditionalRequests(t *testing.T) {
+	s := newTestServer(t)
+
+	first := get(s, "/api/servers/v1", nil)
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" || strings.HasPrefix(etag, "W/") {
+		t.Fatalf("expected 200 with a strong ETag, got %d %q", first.Code, etag)
+	}
+	if got := first.Header().Get("Cache-Control"); got != "public, no-cache" {
+		t.Errorf("expected the API cache policy, got %q", got)
+	}
+	lastModified := first.Header().Get("Last-Modified")
+	if lastModified == "" {
+		t.Error("expected a Last-Modified header")
+	}
+
+	cached := get(s, "/api/servers/v1", map[string]string{"If-None-Match": etag})
+	if cached.Code != http.StatusNotModified || cached.Body.Len() != 0 || cached.Header().Get("ETag") != etag {
+		t.Fatalf("expected an empty 304 carrying the ETag, got %d %q", cached.Code, cached.Body)
+	}
+	if cached := get(s, "/api/servers/v1", map[string]string{"If-Modified-Since": lastModified}); cached.Code != http.StatusNotModified {
+		t.Errorf("expected 304 for an unchanged If-Modified-Since, got %d", cached.Code)
+	}
+
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/servers/v1",
+		strings.NewReader(`{"name":"Alpha","description":"first","transport":"stdio","status":"new"}`)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body)
+	}
+
+	if changed := get(s, "/api/servers/v1", map[string]string{"If-None-Match": etag}); changed.Code != http.StatusOK || changed.Header().Get("ETag") == etag {
+		t.Errorf("expected a new body and ETag after a write, got %d", changed.Code)
+	}
+}
+
+// TestCaching_LastModifiedFollowsCatalogWrites checks that only writes the
+// catalog accepts move Last-Modified, not every successful POST
+func TestCaching_LastModifiedFollowsCatalogWrites(t *testing.T) {
+	s := newTestServer(t)
+
+	postGraphQL(t, s, "", `{ servers(first: 1) { totalCount } }`, nil)
+	runImport(t, s, "dryRun=true", importBody)
+	runImport(t, s, "", `{"name":"Alpha"}`)
+	if rec := send(s, http.MethodPost, "/api/webhooks/v1", `{"url":"https://example.com/hook"}`); rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body)
+	}
+	if got := s.catalogModifiedAt(); !got.Equal(s.startTime) {
+		t.Errorf("expected reads, dry runs, failed imports and webhooks to leave Last-Modified alone, got %v", got)
+	}
+
+	if rec := send(s, http.MethodPost, "/api/tags/v1", `{"name":"Git"}`); rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body)
+	}
+	tagged := s.catalogModifiedAt()
+	if !tagged.After(s.startTime) {
+		t.Errorf("expected a tag write to move Last-Modified, got %v", tagged)
+	}
+
+	if _, err := s.storage.CreateServer(context.Background(), models.Server{Name: "Beta", Description: "second", Transport: "stdio", Status: "new"}); err != nil {
+		t.Fatalf("creating server: %v", err)
+	}
+	if !s.catalogModifiedAt().After(tagged) {
+		t.Error("expected a server write outside HTTP to move Last-Modified")
+	}
+}
+
+func TestCaching_CompressesNegotiatedEncoding(t *testing.T) {
+	s := newTestServer(t)
+
+	plain := get(s, "/api/openapi.json", nil)
+	compressed := get(s, "/api/openapi.json", map[string]string{"Accept-Encoding": "br;q=0.5, gzip"})
+	if got := compressed.Header().Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("expected gzip, got %q", got)
+	}
+	if compressed.Header().Get("Vary") != "Accept-Encoding" {
+		t.Error("expected Vary: Accept-Encoding")
+	}
+	if compressed.Header().Get("ETag") == plain.Header().Get("ETag") {
+		t.Error("expected each encoding to get its own ETag")
+	}
+
+	reader, err := gzip.NewReader(compressed.Body)
+	if err != nil {
+		t.Fatalf("expected a gzip body, got %v", err)
+	}
+	body, _ := io.ReadAll(reader)
+	if string(body) != plain.Body.String() {
+		t.Error("expected the decompressed body to match the plain one")
+	}
+
+	if got := get(s, "/api/openapi.json", map[string]string{"Accept-Encoding": "gzip, br"}).Header().Get("Content-Encoding"); got != "br" {
+		t.Errorf("expected brotli to win a tie, got %q", got)
+	}
+	if got := get(s, "/api/openapi.json", map[string]string{"Accept-Encoding": "gzip;q=0"}).Header().Get("Content-Encoding"); got != "" {
+		t.Errorf("expected no compression when gzip is refused, got %q", got)
+	}
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// capabilitiesResponse is the tool, prompt and resource inventory of a server
+type capabilitiesResponse struct {
+	Tools             []models.Tool             `json:"tools"`
+	Prompts           []models.Prompt           `json:"prompts"`
+	ResourceTemplates []models.ResourceTemplate `json:"resourceTemplates"`
+}
+
+// ListServerTools handles retrieving the tools, prompts and resource
+// templates a server exposes
+func (s *Server) ListServerTools(w http.ResponseWriter, r *http.Request) {
+	server, ok := s.serverFromRequest(w, r, http.StatusPermanentRedirect)
+	if !ok {
+		return
+	}
+
+	response := capabilitiesResponse{
+		Tools:             server.Tools,
+		Prompts:           server.Prompts,
+		ResourceTemplates: server.ResourceTemplates,
+	}
+	if response.Tools == nil {
+		response.Tools = []models.Tool{}
+	}
+	if response.Prompts == nil {
+		response.Prompts = []models.Prompt{}
+	}
+	if response.ResourceTemplates == nil {
+		response.ResourceTemplates = []models.ResourceTemplate{}
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/clientconfig"
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// clientConfigView is a generated host configuration as shown on the server
+// page. NativeInputs marks hosts that resolve ${input:name} themselves, whose
+// configuration the page must not fill in.
+type clientConfigView struct {
+	clientconfig.Host
+	JSON         string
+	NativeInputs bool
+}
+
+func (s *Server) setupClientConfigRoutes() {
+	s.handleServerAPI("GET /{server}/client-configs", s.ListClientConfigs)
+	s.handleServerAPI("GET /{server}/client-configs/{host}", s.GetClientConfig)
+}
+
+// ListClientConfigs renders a server's configuration for every supported
+// MCP host
+func (s *Server) ListClientConfigs(w http.ResponseWriter, r *http.Request) {
+	server, ok := s.serverFromRequest(w, r, http.StatusPermanentRedirect)
+	if !ok {
+		return
+	}
+
+	docs, err := clientconfig.GenerateAll(server)
+	if err != nil {
+		errors.WriteError(w, errors.NewInternalError("Failed to generate client configs", err))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, docs)
+}
+
+// GetClientConfig renders a server's configuration for one MCP host
+func (s *Server) GetClientConfig(w http.ResponseWriter, r *http.Request) {
+	host, known := clientconfig.FindHost(r.PathValue("host"))
+	if !known {
+		errors.WriteError(w, errors.NewNotFoundError("Host").
+			SetDetails(map[string][]string{"hosts": clientconfig.HostIDs()}))
+		return
+	}
+
+	server, ok := s.serverFromRequest(w, r, http.StatusPermanentRedirect)
+	if !ok {
+		return
+	}
+
+	doc, err := clientconfig.Generate(host, server)
+	if err != nil {
+		errors.WriteError(w, errors.NewInternalError("Failed to generate client config", err))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, doc)
+}
+
+// clientConfigViews renders the configuration files shown on the server page
+func clientConfigViews(server models.Server) ([]clientConfigView, error) {
+	docs, err := clientconfig.GenerateAll(server)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]clientConfigView, 0, len(docs))
+	for _, doc := range docs {
+		data, err := json.MarshalIndent(doc.Config, "", "    ")
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, clientConfigView{
+			Host:         doc.Host,
+			JSON:         strings.TrimSpace(string(data)),
+			NativeInputs: doc.ID == clientconfig.VSCode,
+		})
+	}
+
+	return views, nil
+}
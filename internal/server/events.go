@@ -0,0 +1,115 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/events"
+	"github.com/bear-belly/mcp-registry/internal/logger"
+)
+
+// eventStreamContentType is the media type of Server-Sent Events
+const eventStreamContentType = "text/event-stream"
+
+// eventStreamReset is sent in place of a replay when the events after the
+// client's Last-Event-ID are no longer buffered. It has no ID, so the client
+// keeps resuming from the last event it did receive.
+const eventStreamReset = "stream.reset"
+
+// Timing of the event stream
+const (
+	eventRetryInterval  = 3 * time.Second
+	eventHeartbeatEvery = 15 * time.Second
+)
+
+func (s *Server) setupEventRoutes() {
+	s.handleAPI("GET /api/events", s.StreamEvents)
+}
+
+// StreamEvents streams catalog changes as Server-Sent Events. A client that
+// reconnects with Last-Event-ID, or the lastEventId parameter, first gets the
+// events it missed. Comments are sent while the catalog is quiet so that
+// proxies do not close the connection.
+func (s *Server) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	var types []string
+	if value := r.URL.Query().Get("types"); value != "" {
+		for _, eventType := range strings.Split(value, ",") {
+			eventType = strings.TrimSpace(eventType)
+			if !slices.Contains(events.Types, eventType) {
+				errors.WriteError(w, errors.NewBadRequestError("types must be a list of "+strings.Join(events.Types, ", ")))
+				return
+			}
+			types = append(types, eventType)
+		}
+	}
+	wanted := func(event events.Event) bool {
+		return len(types) == 0 || slices.Contains(types, event.Type)
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventId")
+	}
+
+	replay, feed, cancel, complete := s.events.Subscribe(lastEventID)
+	defer cancel()
+
+	w.Header().Set("Content-Type", eventStreamContentType)
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	controller := http.NewResponseController(w)
+
+	fmt.Fprintf(w, "retry: %d\n\n", eventRetryInterval.Milliseconds())
+	if !complete {
+		fmt.Fprintf(w, "event: %s\ndata: {\"reason\":\"The events after %s are no longer available\"}\n\n", eventStreamReset, strings.ReplaceAll(lastEventID, `"`, ""))
+	}
+	for _, event := range replay {
+		if wanted(event) {
+			writeEvent(w, event)
+		}
+	}
+	if err := controller.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(eventHeartbeatEvery)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-feed:
+			if !ok {
+				// Fell too far behind; the client reconnects and replays
+				return
+			}
+			if !wanted(event) {
+				continue
+			}
+			writeEvent(w, event)
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		}
+
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeEvent writes one event in the text/event-stream format. The data is
+// the whole event as a single line of JSON.
+func writeEvent(w http.ResponseWriter, event events.Event) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		logger.Error("Failed to encode event", "error", err)
+		return
+	}
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+}
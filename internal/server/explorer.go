@@ -0,0 +1,182 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/openapi"
+	"github.com/bear-belly/mcp-registry/internal/templates"
+)
+
+// explorerPage is the data behind the API explorer, a rendering of the
+// OpenAPI document grouped by tag
+type explorerPage struct {
+	Info    openapi.Info
+	Groups  []explorerGroup
+	Schemas []explorerSchema
+}
+
+type explorerGroup struct {
+	openapi.Tag
+	Operations []explorerOperation
+}
+
+type explorerOperation struct {
+	*openapi.Operation
+	Method    string
+	Path      string
+	Admin     bool
+	Request   schemaView
+	Responses []explorerResponse
+
+	// RequestTypes lists the JSON and NDJSON media types of the request
+	// body, which the explorer can send from a text area
+	RequestTypes []string
+}
+
+type explorerResponse struct {
+	Status      string
+	Description string
+	Schema      schemaView
+}
+
+type explorerSchema struct {
+	Name   string
+	Schema schemaView
+}
+
+// schemaView shows a schema either as a link to a component (Ref, with
+// Array set for a list of them) or as inline JSON
+type schemaView struct {
+	Ref   string
+	Array bool
+	JSON  string
+}
+
+func (s *Server) setupDocsRoutes() {
+	s.mux.Handle("GET /docs/api", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := templates.PageData{
+			Title:        "API - MCP Registry",
+			PageTemplate: "api",
+			Data:         buildExplorerPage(s.openAPIDocument()),
+		}
+
+		if err := templates.ExecuteTemplate(r.Context(), w, "layout.html", data); err != nil {
+			errors.WriteError(w, errors.NewInternalError("Error rendering template", err))
+		}
+	}))
+}
+
+func buildExplorerPage(doc openapi.Document) explorerPage {
+	page := explorerPage{Info: doc.Info}
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	for _, tag := range doc.Tags {
+		group := explorerGroup{Tag: tag}
+
+		for _, path := range paths {
+			operations := doc.Paths[path].Operations()
+			for _, method := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
+				operation, ok := operations[method]
+				if !ok || !slices.Contains(operation.Tags, tag.Name) {
+					continue
+				}
+				request, requestTypes := requestSchema(operation)
+				group.Operations = append(group.Operations, explorerOperation{
+					Operation:    operation,
+					Method:       method,
+					Path:         path,
+					Admin:        len(operation.Security) > 0,
+					Request:      request,
+					Responses:    responses(operation),
+					RequestTypes: requestTypes,
+				})
+			}
+		}
+
+		if len(group.Operations) > 0 {
+			page.Groups = append(page.Groups, group)
+		}
+	}
+
+	names := make([]string, 0, len(doc.Components.Schemas))
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		page.Schemas = append(page.Schemas, explorerSchema{Name: name, Schema: schemaView{JSON: schemaJSON(doc.Components.Schemas[name])}})
+	}
+
+	return page
+}
+
+// requestSchema returns the schema of the request body along with its JSON
+// and NDJSON media types. When there are several, the schema is that of the
+// first of those.
+func requestSchema(operation *openapi.Operation) (schemaView, []string) {
+	if operation.RequestBody == nil {
+		return schemaView{}, nil
+	}
+
+	mediaTypes := make([]string, 0, len(operation.RequestBody.Content))
+	for mediaType := range operation.RequestBody.Content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	slices.Sort(mediaTypes)
+
+	var jsonTypes []string
+	for _, mediaType := range mediaTypes {
+		if mediaType == "application/json" || mediaType == ndjsonContentType || strings.HasSuffix(mediaType, "+json") {
+			jsonTypes = append(jsonTypes, mediaType)
+		}
+	}
+
+	shown := mediaTypes[0]
+	if len(jsonTypes) > 0 {
+		shown = jsonTypes[0]
+	}
+	return viewSchema(operation.RequestBody.Content[shown].Schema), jsonTypes
+}
+
+func responses(operation *openapi.Operation) []explorerResponse {
+	var list []explorerResponse
+	for status, response := range operation.Responses {
+		entry := explorerResponse{Status: status, Description: response.Description}
+		for _, content := range response.Content {
+			entry.Schema = viewSchema(content.Schema)
+		}
+		list = append(list, entry)
+	}
+	slices.SortFunc(list, func(a, b explorerResponse) int { return strings.Compare(a.Status, b.Status) })
+	return list
+}
+
+func viewSchema(schema *openapi.Schema) schemaView {
+	switch {
+	case schema == nil:
+		return schemaView{}
+	case schema.Ref != "":
+		return schemaView{Ref: componentName(schema.Ref)}
+	case schema.Type == "array" && schema.Items != nil && schema.Items.Ref != "":
+		return schemaView{Ref: componentName(schema.Items.Ref), Array: true}
+	}
+	return schemaView{JSON: schemaJSON(schema)}
+}
+
+func componentName(ref string) string {
+	return strings.TrimPrefix(ref, "#/components/schemas/")
+}
+
+func schemaJSON(schema *openapi.Schema) string {
+	data, _ := json.MarshalIndent(schema, "", "  ")
+	return string(data)
+}
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// serverFilter reports whether a server matches a single query parameter value
+type serverFilter func(server models.Server, value string) bool
+
+// serverFilters maps API query parameters onto the predicate they apply.
+// Repeating a parameter matches any of its values; different parameters must
+// all match.
+var serverFilters = map[string]serverFilter{
+	"status":       matchStatus,
+	"transport":    matchTransport,
+	"q":            matchText,
+	"createdAfter": matchCreatedAfter,
+	"updatedSince": matchUpdatedSince,
+	"owner":        matchOwner,
+	"vendor":       matchVendor,
+	"vendorType":   matchVendorType,
+	"supportTier":  matchSupportTier,
+	"tag":          matchTag,
+	"category":     matchCategory,
+	"risk":         matchRiskLevel,
+	"minRisk":      matchMinRisk,
+	"maxRisk":      matchMaxRisk,
+}
+
+// filterValueChecks reject filter values that can never match, so that a
+// typo is reported instead of silently returning nothing
+var filterValueChecks = map[string]func(value string) error{
+	"status":       checkOneOf(models.Statuses),
+	"transport":    checkOneOf(models.Transports),
+	"createdAfter": checkTimestamp,
+	"updatedSince": checkTimestamp,
+	"minRisk":      checkScore,
+	"maxRisk":      checkScore,
+}
+
+// filterServers returns the servers matching every known filter in the query
+func filterServers(servers []models.Server, query url.Values) []models.Server {
+	filtered := []models.Server{}
+
+	for _, server := range servers {
+		if matchesQuery(server, query) {
+			filtered = append(filtered, server)
+		}
+	}
+
+	return filtered
+}
+
+func matchesQuery(server models.Server, query url.Values) bool {
+	for param, values := range query {
+		filter, ok := serverFilters[param]
+		if !ok {
+			continue
+		}
+
+		matched := false
+		for _, value := range values {
+			if filter(server, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchStatus(server models.Server, value string) bool {
+	return strings.EqualFold(server.Status, value)
+}
+
+func matchTransport(server models.Server, value string) bool {
+	return strings.EqualFold(server.TransportType(), value)
+}
+
+// matchText searches the name, slug, description and tags, ignoring case
+func matchText(server models.Server, value string) bool {
+	value = strings.ToLower(value)
+	for _, text := range append([]string{server.Name, server.Slug, server.Description}, server.Tags...) {
+		if strings.Contains(strings.ToLower(text), value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchCreatedAfter(server models.Server, value string) bool {
+	t, err := time.Parse(time.RFC3339, value)
+	return err == nil && server.CreatedAt.After(t)
+}
+
+func matchUpdatedSince(server models.Server, value string) bool {
+	t, err := time.Parse(time.RFC3339, value)
+	return err == nil && !server.UpdatedAt.Before(t)
+}
+
+// matchOwner matches the owning team or either contact's name or email
+func matchOwner(server models.Server, value string) bool {
+	owner := server.Ownership
+	if owner == nil {
+		return false
+	}
+
+	if strings.EqualFold(owner.Team, value) {
+		return true
+	}
+
+	for _, contact := range []*models.Contact{owner.TechnicalContact, owner.BusinessContact} {
+		if contact != nil && (strings.EqualFold(contact.Name, value) || strings.EqualFold(contact.Email, value)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchVendor(server models.Server, value string) bool {
+	return server.Ownership != nil && server.Ownership.Vendor != nil &&
+		strings.EqualFold(server.Ownership.Vendor.Name, value)
+}
+
+func matchVendorType(server models.Server, value string) bool {
+	return server.Ownership != nil && server.Ownership.Vendor != nil &&
+		strings.EqualFold(server.Ownership.Vendor.Type, value)
+}
+
+func matchSupportTier(server models.Server, value string) bool {
+	return server.Ownership != nil && strings.EqualFold(server.Ownership.SupportTier, value)
+}
+
+func matchTag(server models.Server, value string) bool {
+	return slices.Contains(server.Tags, value)
+}
+
+func matchCategory(server models.Server, value string) bool {
+	return slices.Contains(server.Categories, value)
+}
+
+// The risk filters expect the server's RiskAssessment to have been computed
+
+func matchRiskLevel(server models.Server, value string) bool {
+	return server.RiskAssessment != nil && strings.EqualFold(server.RiskAssessment.Level, value)
+}
+
+func matchMinRisk(server models.Server, value string) bool {
+	score, err := strconv.Atoi(value)
+	return err == nil && server.RiskAssessment != nil && server.RiskAssessment.Score >= score
+}
+
+func matchMaxRisk(server models.Server, value string) bool {
+	score, err := strconv.Atoi(value)
+	return err == nil && server.RiskAssessment != nil && server.RiskAssessment.Score <= score
+}
+
+func checkOneOf(allowed []string) func(string) error {
+	return func(value string) error {
+		if !slices.Contains(allowed, strings.ToLower(value)) {
+			return fmt.Errorf("must be one of %s", strings.Join(allowed, ", "))
+		}
+		return nil
+	}
+}
+
+func checkTimestamp(value string) error {
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		return fmt.Errorf("must be an RFC 3339 timestamp such as 2025-01-31T00:00:00Z")
+	}
+	return nil
+}
+
+func checkScore(value string) error {
+	if _, err := strconv.Atoi(value); err != nil {
+		return fmt.Errorf("must be a whole number")
+	}
+	return nil
+}
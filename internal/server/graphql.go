@@ -0,0 +1,236 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/middleware"
+)
+
+// Query complexity limits. Every field costs one, and the fields below a
+// list are counted once per item it may hold: first when the query gives it,
+// and otherwise the default page size for connections or
+// graphQLDefaultListSize for other lists.
+const (
+	maxGraphQLComplexity   = 10000
+	graphQLDefaultListSize = 10
+)
+
+// graphQLRequest is a GraphQL request as sent over HTTP. Extensions are
+// accepted, since common clients send them, and ignored.
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+	Extensions    map[string]any `json:"extensions,omitempty"`
+}
+
+func (s *Server) setupGraphQLRoutes() {
+	schema, err := s.newGraphQLSchema()
+	if err != nil {
+		panic(fmt.Sprintf("building the GraphQL schema: %v", err))
+	}
+	s.graphQLSchema = schema
+
+	s.handleAPI("GET /api/graphql", s.ServeGraphQL)
+	s.handleAPI("POST /api/graphql", s.ServeGraphQL)
+}
+
+// ServeGraphQL executes a GraphQL query. POST takes the request as a JSON
+// body; GET takes it as query parameters, with the variables as JSON, and
+// only runs queries. Problems with the query itself are reported in the
+// errors of a 200 response, as GraphQL clients expect.
+func (s *Server) ServeGraphQL(w http.ResponseWriter, r *http.Request) {
+	var request graphQLRequest
+	if r.Method == http.MethodPost {
+		if err := readJSON(w, r, &request); err != nil {
+			errors.WriteError(w, err)
+			return
+		}
+	} else {
+		params := r.URL.Query()
+		request.Query = params.Get("query")
+		request.OperationName = params.Get("operationName")
+		if variables := params.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				errors.WriteError(w, errors.NewBadRequestError("variables must be a JSON object"))
+				return
+			}
+		}
+	}
+	if request.Query == "" {
+		errors.WriteError(w, errors.NewBadRequestError("A query is required"))
+		return
+	}
+
+	document, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{
+		Body: []byte(request.Query),
+		Name: "GraphQL request",
+	})})
+	if err != nil {
+		writeJSON(w, http.StatusOK, &graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+		return
+	}
+
+	if validation := graphql.ValidateDocument(&s.graphQLSchema, document, nil); !validation.IsValid {
+		writeJSON(w, http.StatusOK, &graphql.Result{Errors: validation.Errors})
+		return
+	}
+
+	operation := findOperation(document, request.OperationName)
+	if operation != nil && operation.Operation == ast.OperationTypeMutation && r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		errors.WriteError(w, errors.NewBadRequestError("Mutations must be sent with POST").SetStatusCode(http.StatusMethodNotAllowed))
+		return
+	}
+
+	if operation != nil {
+		complexity := s.graphQLComplexity(document, operation, request.Variables)
+		if complexity > maxGraphQLComplexity {
+			limitErr := gqlerrors.NewFormattedError(fmt.Sprintf("Query complexity %d exceeds the limit of %d", complexity, maxGraphQLComplexity))
+			limitErr.Extensions = map[string]any{"code": "complexity_limit", "complexity": complexity, "limit": maxGraphQLComplexity}
+			writeJSON(w, http.StatusOK, &graphql.Result{Errors: []gqlerrors.FormattedError{limitErr}})
+			return
+		}
+	}
+
+	loader := &graphQLLoader{adminErr: middleware.CheckAdminToken(s.config.AdminToken, r.Header)}
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.graphQLSchema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       context.WithValue(r.Context(), graphQLLoaderKey{}, loader),
+	})
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// findOperation returns the operation a request runs: the one named, or the
+// only one. It returns nil when there is no such operation, which execution
+// reports.
+func findOperation(document *ast.Document, name string) *ast.OperationDefinition {
+	var found *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if name == "" {
+			if found != nil {
+				return nil
+			}
+			found = operation
+		} else if operation.Name != nil && operation.Name.Value == name {
+			return operation
+		}
+	}
+	return found
+}
+
+// graphQLComplexity estimates the cost of running an operation. The
+// document must have been validated, which rules out fragment cycles.
+func (s *Server) graphQLComplexity(document *ast.Document, operation *ast.OperationDefinition, variables map[string]any) int {
+	counter := complexityCounter{fragments: map[string]*ast.FragmentDefinition{}, variables: variables}
+	for _, definition := range document.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			counter.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	root := s.graphQLSchema.QueryType()
+	if operation.Operation == ast.OperationTypeMutation {
+		root = s.graphQLSchema.MutationType()
+	}
+	return counter.selectionSet(operation.SelectionSet, root)
+}
+
+type complexityCounter struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+}
+
+// selectionSet sums the cost of the fields selected on parent, which is nil
+// for the types the schema does not describe, such as introspection
+func (c complexityCounter) selectionSet(set *ast.SelectionSet, parent *graphql.Object) int {
+	if set == nil {
+		return 0
+	}
+
+	total := 0
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			total += c.field(selection, parent)
+		case *ast.InlineFragment:
+			// Every type in the schema is an object, so a fragment can only
+			// apply to the parent itself
+			total += c.selectionSet(selection.SelectionSet, parent)
+		case *ast.FragmentSpread:
+			if fragment, ok := c.fragments[selection.Name.Value]; ok {
+				total += c.selectionSet(fragment.SelectionSet, parent)
+			}
+		}
+	}
+	return total
+}
+
+func (c complexityCounter) field(field *ast.Field, parent *graphql.Object) int {
+	var definition *graphql.FieldDefinition
+	if parent != nil {
+		definition = parent.Fields()[field.Name.Value]
+	}
+	if definition == nil {
+		return 1 + c.selectionSet(field.SelectionSet, nil)
+	}
+
+	fieldType, items := definition.Type, 1
+	if nonNull, ok := fieldType.(*graphql.NonNull); ok {
+		fieldType = nonNull.OfType
+	}
+	switch {
+	case strings.HasSuffix(fieldType.Name(), "Connection"):
+		items = c.first(field, defaultServerPageSize)
+	case strings.HasSuffix(parent.Name(), "Connection"):
+		// The edges of a connection were counted on the connection
+	default:
+		if _, ok := fieldType.(*graphql.List); ok {
+			items = c.first(field, graphQLDefaultListSize)
+		}
+	}
+
+	child, _ := graphql.GetNamed(fieldType).(*graphql.Object)
+	return 1 + items*c.selectionSet(field.SelectionSet, child)
+}
+
+// first is the value of the field's first argument, or fallback when it has
+// none that can be read
+func (c complexityCounter) first(field *ast.Field, fallback int) int {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "first" {
+			continue
+		}
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(value.Value); err == nil && n > 0 {
+				return n
+			}
+		case *ast.Variable:
+			if n, ok := c.variables[value.Name.Value].(float64); ok && n > 0 {
+				return int(n)
+			}
+		}
+	}
+	return fallback
+}
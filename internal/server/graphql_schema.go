@@ -0,0 +1,582 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/logger"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// serverConnection is a page of servers in the shape of a Relay connection
+type serverConnection struct {
+	Edges      []serverEdge `json:"edges"`
+	PageInfo   pageInfo     `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
+}
+
+type serverEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   models.Server `json:"node"`
+}
+
+type pageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor,omitempty"`
+}
+
+// owner is a team that owns servers. Teams are not managed on their own;
+// they are the distinct ownership teams of the catalog.
+type owner struct {
+	Team string `json:"team"`
+}
+
+// graphQLLoader holds what a single GraphQL request has loaded, so that a
+// query touching many servers reads the catalog once
+type graphQLLoader struct {
+	// adminErr is why the request may not act as an admin, if it may not
+	adminErr error
+
+	serversOnce sync.Once
+	servers     []models.Server
+	serversErr  error
+
+	tagsOnce sync.Once
+	tags     map[string]models.Tag
+	tagsErr  error
+}
+
+type graphQLLoaderKey struct{}
+
+func loaderFrom(ctx context.Context) *graphQLLoader {
+	if loader, ok := ctx.Value(graphQLLoaderKey{}).(*graphQLLoader); ok {
+		return loader
+	}
+	return &graphQLLoader{adminErr: errors.NewAuthorizationError("Admin access could not be established")}
+}
+
+// allServers returns every server, assessed, loading them on first use
+func (s *Server) allServers(ctx context.Context) ([]models.Server, error) {
+	loader := loaderFrom(ctx)
+	loader.serversOnce.Do(func() {
+		loader.servers, loader.serversErr = s.storage.ListServers(ctx)
+		s.assessServers(loader.servers)
+	})
+	return loader.servers, loader.serversErr
+}
+
+// managedTags returns the managed tags by slug, loading them on first use
+func (s *Server) managedTags(ctx context.Context) (map[string]models.Tag, error) {
+	loader := loaderFrom(ctx)
+	loader.tagsOnce.Do(func() {
+		var tags []models.Tag
+		tags, loader.tagsErr = s.storage.ListTags(ctx)
+		loader.tags = map[string]models.Tag{}
+		for _, tag := range tags {
+			loader.tags[tag.Slug] = tag
+		}
+	})
+	return loader.tags, loader.tagsErr
+}
+
+// graphQLError is an error as reported in a GraphQL response, with the error
+// type under extensions.code
+type graphQLError struct {
+	message string
+	code    errors.ErrorType
+	details any
+}
+
+func (e graphQLError) Error() string {
+	return e.message
+}
+
+func (e graphQLError) Extensions() map[string]any {
+	extensions := map[string]any{"code": e.code}
+	if e.details != nil {
+		extensions["details"] = e.details
+	}
+	return extensions
+}
+
+// resolverError reports an application error to the client as it is. Other
+// failures are logged and reported without their cause, as the REST API
+// does.
+func resolverError(message string, err error) error {
+	appErr, ok := err.(*errors.AppError)
+	if ok && appErr.Type != errors.ErrorTypeInternal && appErr.Type != errors.ErrorTypeDatabase {
+		return graphQLError{message: appErr.Message, code: appErr.Type, details: appErr.Details}
+	}
+	logger.Error(message, "error", err)
+	return graphQLError{message: message, code: errors.ErrorTypeInternal}
+}
+
+// newGraphQLSchema builds the GraphQL schema over the catalog. Lists of
+// servers are connections, filtered with the same arguments as the REST list
+// and paged with the same cursors.
+func (s *Server) newGraphQLSchema() (graphql.Schema, error) {
+	contactType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Contact",
+		Fields: graphql.Fields{
+			"name":  &graphql.Field{Type: graphql.String},
+			"email": &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	ownershipType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Ownership",
+		Description: "Who is responsible for a server and how to reach them",
+		Fields: graphql.Fields{
+			"team":              &graphql.Field{Type: graphql.String},
+			"supportTier":       &graphql.Field{Type: graphql.String},
+			"escalationChannel": &graphql.Field{Type: graphql.String},
+			"technicalContact":  &graphql.Field{Type: contactType},
+			"businessContact":   &graphql.Field{Type: contactType},
+			"vendor": &graphql.Field{Type: graphql.NewObject(graphql.ObjectConfig{
+				Name: "Vendor",
+				Fields: graphql.Fields{
+					"name": &graphql.Field{Type: graphql.String},
+					"type": &graphql.Field{Type: graphql.String},
+				},
+			})},
+			"documentation": &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+				Name: "Link",
+				Fields: graphql.Fields{
+					"title": &graphql.Field{Type: graphql.String},
+					"url":   &graphql.Field{Type: graphql.String},
+				},
+			})))},
+		},
+	})
+
+	toolType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Tool",
+		Fields: graphql.Fields{
+			"name":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"title":       &graphql.Field{Type: graphql.String},
+			"description": &graphql.Field{Type: graphql.String},
+			"readOnly": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.Tool).IsReadOnly(), nil
+				},
+			},
+			"destructive": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.Tool).IsDestructive(), nil
+				},
+			},
+			"inputSchema": &graphql.Field{
+				Type:        graphql.String,
+				Description: "JSON Schema of the tool's arguments, as a JSON document",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					tool := p.Source.(models.Tool)
+					if tool.InputSchema == nil {
+						return nil, nil
+					}
+					encoded, err := json.Marshal(tool.InputSchema)
+					return string(encoded), err
+				},
+			},
+		},
+	})
+
+	riskType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "RiskAssessment",
+		Fields: graphql.Fields{
+			"score": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"level": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	// Servers, tags and owners refer to each other, so their fields are
+	// built once all three types exist
+	var serverType, tagType, ownerType, connectionType *graphql.Object
+
+	serverType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Server",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":              &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"slug":            &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"name":            &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"description":     &graphql.Field{Type: graphql.String},
+				"version":         &graphql.Field{Type: graphql.String},
+				"transport":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"status":          &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"url":             &graphql.Field{Type: graphql.String},
+				"license":         &graphql.Field{Type: graphql.String},
+				"licenseDecision": &graphql.Field{Type: graphql.String},
+				"createdAt":       &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+				"updatedAt":       &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+				"categories": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return append([]string{}, p.Source.(models.Server).Categories...), nil
+					},
+				},
+				"owner": &graphql.Field{
+					Type: ownershipType,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return p.Source.(models.Server).Ownership, nil
+					},
+				},
+				"risk": &graphql.Field{
+					Type: riskType,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return p.Source.(models.Server).RiskAssessment, nil
+					},
+				},
+				"tags": &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(tagType))),
+					Description: "Tags of the server. Tags outside the managed vocabulary are named after their slug.",
+					Resolve:     s.resolveServerTags,
+				},
+				"tools": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(toolType))),
+					Args: graphql.FieldConfigArgument{
+						"first": &graphql.ArgumentConfig{Type: graphql.Int, Description: "Only the first this many tools"},
+					},
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						tools := p.Source.(models.Server).Tools
+						if first, ok := p.Args["first"].(int); ok && first >= 0 && first < len(tools) {
+							tools = tools[:first]
+						}
+						return tools, nil
+					},
+				},
+				"versions": &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(serverType))),
+					Description: "The last record written for each version of the server, newest first",
+					Resolve:     s.resolveServerVersions,
+				},
+			}
+		}),
+	})
+
+	connectionType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "ServerConnection",
+		Fields: graphql.Fields{
+			"edges": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+				Name: "ServerEdge",
+				Fields: graphql.Fields{
+					"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+					"node":   &graphql.Field{Type: graphql.NewNonNull(serverType)},
+				},
+			}))))},
+			"pageInfo": &graphql.Field{Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+				Name: "PageInfo",
+				Fields: graphql.Fields{
+					"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+					"endCursor":   &graphql.Field{Type: graphql.String},
+				},
+			}))},
+			"totalCount": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	tagType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Tag",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"slug":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"name":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"description": &graphql.Field{Type: graphql.String},
+				"servers": &graphql.Field{
+					Type: graphql.NewNonNull(connectionType),
+					Args: serverConnectionArgs("tag"),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return s.resolveServerConnection(p, url.Values{"tag": {p.Source.(models.Tag).Slug}})
+					},
+				},
+			}
+		}),
+	})
+
+	ownerType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Owner",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"team": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"servers": &graphql.Field{
+					Type: graphql.NewNonNull(connectionType),
+					Args: serverConnectionArgs("owner"),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return s.resolveServerConnection(p, url.Values{"owner": {p.Source.(owner).Team}})
+					},
+				},
+			}
+		}),
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"server": &graphql.Field{
+				Type:        serverType,
+				Description: "A server by ID or slug. Previous slugs also match.",
+				Args: graphql.FieldConfigArgument{
+					"id":   &graphql.ArgumentConfig{Type: graphql.ID},
+					"slug": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: s.resolveServer,
+			},
+			"servers": &graphql.Field{
+				Type:    graphql.NewNonNull(connectionType),
+				Args:    serverConnectionArgs(""),
+				Resolve: func(p graphql.ResolveParams) (any, error) { return s.resolveServerConnection(p, nil) },
+			},
+			"tags": &graphql.Field{
+				Type:    graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(tagType))),
+				Resolve: s.resolveTags,
+			},
+			"tag": &graphql.Field{
+				Type: tagType,
+				Args: graphql.FieldConfigArgument{
+					"slug": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					tags, err := s.managedTags(p.Context)
+					if err != nil {
+						return nil, resolverError("Failed to retrieve tags", err)
+					}
+					if tag, ok := tags[p.Args["slug"].(string)]; ok {
+						return tag, nil
+					}
+					return nil, nil
+				},
+			},
+			"owners": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(ownerType))),
+				Description: "The teams that own servers, by name",
+				Resolve:     s.resolveOwners,
+			},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"changeServerStatus": &graphql.Field{
+				Type:        graphql.NewNonNull(serverType),
+				Description: "Move a server through the approval workflow. Requires the admin token.",
+				Args: graphql.FieldConfigArgument{
+					"id":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID), Description: "Server ID or slug"},
+					"status": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String), Description: "One of " + strings.Join(models.Statuses, ", ")},
+				},
+				Resolve: s.resolveChangeServerStatus,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query, Mutation: mutation})
+}
+
+// serverConnectionArgs are the paging and filter arguments of a server
+// connection. Filters are named after the REST list parameters; those taking
+// a list match any of the values. The fixed filter is left out.
+func serverConnectionArgs(fixed string) graphql.FieldConfigArgument {
+	args := graphql.FieldConfigArgument{
+		"first": &graphql.ArgumentConfig{Type: graphql.Int, Description: fmt.Sprintf("Page size, 1 to %d. Defaults to %d.", maxServerPageSize, defaultServerPageSize)},
+		"after": &graphql.ArgumentConfig{Type: graphql.String, Description: "Cursor of the edge to continue after"},
+		"sort": &graphql.ArgumentConfig{
+			Type:        graphql.String,
+			Description: "One of " + strings.Join(sortedMapKeys(serverSortKeys), ", ") + ", optionally prefixed with - for descending order. Defaults to name.",
+		},
+		"createdAfter": &graphql.ArgumentConfig{Type: graphql.DateTime},
+		"updatedSince": &graphql.ArgumentConfig{Type: graphql.DateTime},
+		"minRisk":      &graphql.ArgumentConfig{Type: graphql.Int},
+		"maxRisk":      &graphql.ArgumentConfig{Type: graphql.Int},
+	}
+	for _, name := range []string{"status", "transport", "q", "owner", "vendor", "vendorType", "supportTier", "tag", "category", "risk"} {
+		args[name] = &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))}
+	}
+	delete(args, fixed)
+	return args
+}
+
+// resolveServerConnection pages the servers matching the field's arguments
+// and the fixed filters. The arguments are turned into list parameters so
+// that they are checked and applied exactly as the REST list does.
+func (s *Server) resolveServerConnection(p graphql.ResolveParams, fixed url.Values) (any, error) {
+	values := url.Values{}
+	for name, arg := range p.Args {
+		switch arg := arg.(type) {
+		case []any:
+			for _, value := range arg {
+				values.Add(name, fmt.Sprint(value))
+			}
+		case time.Time:
+			values.Set(name, arg.Format(time.RFC3339Nano))
+		default:
+			values.Set(name, fmt.Sprint(arg))
+		}
+	}
+	if first, ok := p.Args["first"].(int); ok && (first < 1 || first > maxServerPageSize) {
+		return nil, graphQLError{message: fmt.Sprintf("first must be between 1 and %d", maxServerPageSize), code: errors.ErrorTypeBadRequest}
+	}
+	renameValue(values, "first", "limit")
+	renameValue(values, "after", "cursor")
+	for name, value := range fixed {
+		values[name] = value
+	}
+
+	query, err := parseServerListQuery(values, serverCodecV1)
+	if err != nil {
+		return nil, resolverError("Invalid server list arguments", err)
+	}
+
+	servers, err := s.allServers(p.Context)
+	if err != nil {
+		return nil, resolverError("Failed to retrieve servers", err)
+	}
+
+	list, err := query.page(filterServers(servers, values))
+	if err != nil {
+		return nil, resolverError("Failed to build the server list", err)
+	}
+
+	connection := serverConnection{Edges: []serverEdge{}, TotalCount: list.Total}
+	for _, item := range list.Items {
+		server := item.(models.Server)
+		cursor := encodeListCursor(listCursor{Sort: query.sort, Key: query.key(server), ID: server.ID})
+		connection.Edges = append(connection.Edges, serverEdge{Cursor: cursor, Node: server})
+		connection.PageInfo.EndCursor = cursor
+	}
+	connection.PageInfo.HasNextPage = list.NextCursor != ""
+
+	return connection, nil
+}
+
+func renameValue(values url.Values, from, to string) {
+	if value, ok := values[from]; ok {
+		values[to] = value
+		delete(values, from)
+	}
+}
+
+func (s *Server) resolveServer(p graphql.ResolveParams) (any, error) {
+	id, _ := p.Args["id"].(string)
+	slug, _ := p.Args["slug"].(string)
+	if (id == "") == (slug == "") {
+		return nil, graphQLError{message: "Exactly one of id and slug must be given", code: errors.ErrorTypeBadRequest}
+	}
+
+	var server models.Server
+	var err error
+	if id != "" {
+		server, err = s.storage.GetServer(p.Context, id)
+	} else {
+		server, err = s.storage.GetServerBySlug(p.Context, slug)
+	}
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, resolverError("Failed to retrieve server", err)
+	}
+
+	return s.assess(server), nil
+}
+
+func (s *Server) resolveServerTags(p graphql.ResolveParams) (any, error) {
+	server := p.Source.(models.Server)
+	managed, err := s.managedTags(p.Context)
+	if err != nil {
+		return nil, resolverError("Failed to retrieve tags", err)
+	}
+
+	tags := make([]models.Tag, 0, len(server.Tags))
+	for _, slug := range server.Tags {
+		tag, ok := managed[slug]
+		if !ok {
+			tag = models.Tag{Slug: slug, Name: slug}
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func (s *Server) resolveServerVersions(p graphql.ResolveParams) (any, error) {
+	versions, err := s.storage.ListServerVersions(p.Context, p.Source.(models.Server).ID)
+	if err != nil {
+		return nil, resolverError("Failed to retrieve server versions", err)
+	}
+	s.assessServers(versions)
+	return versions, nil
+}
+
+func (s *Server) resolveTags(p graphql.ResolveParams) (any, error) {
+	managed, err := s.managedTags(p.Context)
+	if err != nil {
+		return nil, resolverError("Failed to retrieve tags", err)
+	}
+
+	tags := make([]models.Tag, 0, len(managed))
+	for _, slug := range sortedMapKeys(managed) {
+		tags = append(tags, managed[slug])
+	}
+	return tags, nil
+}
+
+func (s *Server) resolveOwners(p graphql.ResolveParams) (any, error) {
+	servers, err := s.allServers(p.Context)
+	if err != nil {
+		return nil, resolverError("Failed to retrieve servers", err)
+	}
+
+	teams := []string{}
+	for _, server := range servers {
+		if server.Ownership != nil && server.Ownership.Team != "" && !slices.Contains(teams, server.Ownership.Team) {
+			teams = append(teams, server.Ownership.Team)
+		}
+	}
+	slices.SortFunc(teams, func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) })
+
+	owners := make([]owner, len(teams))
+	for i, team := range teams {
+		owners[i] = owner{Team: team}
+	}
+	return owners, nil
+}
+
+// resolveChangeServerStatus applies the same workflow rules as the REST
+// status route
+func (s *Server) resolveChangeServerStatus(p graphql.ResolveParams) (any, error) {
+	if err := loaderFrom(p.Context).adminErr; err != nil {
+		return nil, resolverError("Not allowed", err)
+	}
+
+	found, _, err := s.lookupServer(p.Context, p.Args["id"].(string))
+	if err != nil {
+		return nil, resolverError("Failed to retrieve server", err)
+	}
+	server, unlock, err := s.lockedServer(p.Context, found.ID)
+	if err != nil {
+		return nil, resolverError("Failed to retrieve server", err)
+	}
+	defer unlock()
+
+	status := p.Args["status"].(string)
+	if err := checkTransition(server.Status, status); err != nil {
+		return nil, resolverError("Invalid status change", err)
+	}
+
+	server.Status = status
+	updated, err := s.storage.UpdateServer(p.Context, server)
+	if err != nil {
+		return nil, resolverError("Failed to change server status", err)
+	}
+
+	return s.assess(updated), nil
+}
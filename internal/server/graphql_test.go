@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+type graphQLResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func postGraphQL(t *testing.T, s *Server, token string, query string, variables map[string]any) graphQLResponse {
+	t.Helper()
+
+	body, _ := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+	r := httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(string(body)))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, r)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+	}
+
+	var response graphQLResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	return response
+}
+
+func createTestServers(t *testing.T, s *Server, names ...string) {
+	t.Helper()
+	if err := s.storage.SaveTag(context.Background(), models.Tag{Slug: "git", Name: "Git"}); err != nil {
+		t.Fatalf("creating tag: %v", err)
+	}
+	for _, name := range names {
+		server := models.Server{Name: name, Description: name, Transport: models.TransportStdio, Status: models.StatusNew, Tags: []string{"git"}}
+		if _, err := s.storage.CreateServer(context.Background(), server); err != nil {
+			t.Fatalf("creating %s: %v", name, err)
+		}
+	}
+}
+
+func TestGraphQL_ConnectionPagesWithCursors(t *testing.T) {
+	s := newTestServer(t)
+	createTestServers(t, s, "Alpha", "Bravo", "Charlie")
+
+	const query = `query($after: String) {
+		servers(first: 2, after: $after, tag: "git") {
+			totalCount
+			edges { node { name tags { slug } } }
+			pageInfo { hasNextPage endCursor }
+		}
+	}`
+
+	var names []string
+	variables := map[string]any{}
+	for page := 0; page < 3; page++ {
+		response := postGraphQL(t, s, "", query, variables)
+		if len(response.Errors) > 0 {
+			t.Fatalf("unexpected errors: %+v", response.Errors)
+		}
+
+		var servers struct {
+			TotalCount int `json:"totalCount"`
+			Edges      []struct {
+				Node struct {
+					Name string `json:"name"`
+					Tags []struct {
+						Slug string `json:"slug"`
+					} `json:"tags"`
+				} `json:"node"`
+			} `json:"edges"`
+			PageInfo pageInfo `json:"pageInfo"`
+		}
+		json.Unmarshal(response.Data["servers"], &servers)
+		if servers.TotalCount != 3 {
+			t.Fatalf("expected a total of 3, got %d", servers.TotalCount)
+		}
+		for _, edge := range servers.Edges {
+			names = append(names, edge.Node.Name)
+			if len(edge.Node.Tags) != 1 || edge.Node.Tags[0].Slug != "git" {
+				t.Errorf("expected %s to resolve its tag, got %+v", edge.Node.Name, edge.Node.Tags)
+			}
+		}
+		if !servers.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = servers.PageInfo.EndCursor
+	}
+
+	if strings.Join(names, ",") != "Alpha,Bravo,Charlie" {
+		t.Errorf("expected every server once in name order, got %v", names)
+	}
+}
+
+func TestGraphQL_RejectsComplexQueries(t *testing.T) {
+	s := newTestServer(t)
+
+	response := postGraphQL(t, s, "", `{
+		servers(first: 500) { edges { node { versions { tools { name description } } } } }
+	}`, nil)
+	if len(response.Errors) != 1 || response.Errors[0].Extensions["code"] != "complexity_limit" || response.Data != nil {
+		t.Fatalf("expected the query to be rejected for its complexity, got %+v", response)
+	}
+
+	response = postGraphQL(t, s, "", `{ servers(first: 5) { edges { node { versions { tools { name } } } } } }`, nil)
+	if len(response.Errors) != 0 {
+		t.Errorf("expected a small page to run, got %+v", response.Errors)
+	}
+}
+
+func TestGraphQL_ChangeServerStatus(t *testing.T) {
+	s := newTestServer(t)
+	s.config.AdminToken = "secret"
+	createTestServers(t, s, "Alpha")
+
+	const mutation = `mutation($status: String!) { changeServerStatus(id: "alpha", status: $status) { status } }`
+
+	response := postGraphQL(t, s, "", mutation, map[string]any{"status": models.StatusInReview})
+	if len(response.Errors) != 1 || response.Errors[0].Extensions["code"] != "authentication" {
+		t.Fatalf("expected the mutation to require the admin token, got %+v", response.Errors)
+	}
+
+	response = postGraphQL(t, s, "secret", mutation, map[string]any{"status": models.StatusApproved})
+	if len(response.Errors) != 1 || response.Errors[0].Extensions["code"] != "conflict" {
+		t.Fatalf("expected the workflow to forbid new to approved, got %+v", response.Errors)
+	}
+
+	response = postGraphQL(t, s, "secret", mutation, map[string]any{"status": models.StatusInReview})
+	if len(response.Errors) != 0 || string(response.Data["changeServerStatus"]) != `{"status":"in-review"}` {
+		t.Fatalf("expected the status to change, got %+v %s", response.Errors, response.Data["changeServerStatus"])
+	}
+
+	server, err := s.storage.GetServerBySlug(context.Background(), "alpha")
+	if err != nil || server.Status != models.StatusInReview {
+		t.Errorf("expected the change to be stored, got %q (%v)", server.Status, err)
+	}
+}
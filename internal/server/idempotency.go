@@ -0,0 +1,239 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/logger"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+
+	// defaultIdempotencyWindow applies when the configuration sets none
+	defaultIdempotencyWindow = 24 * time.Hour
+
+	// maxStoredResponseBytes bounds the responses kept for replay. Larger
+	// responses are sent but not stored.
+	maxStoredResponseBytes = 8 << 20
+
+	// idempotencyCleanupInterval is how often expired records are deleted
+	idempotencyCleanupInterval = time.Hour
+)
+
+// unreplayedHeaders are left out of stored responses, since the middleware
+// outside this one sets them again for the retry
+var unreplayedHeaders = []string{"Content-Encoding", "Content-Length", "Vary"}
+
+// idempotencyMiddleware makes POST and PATCH requests that carry an
+// Idempotency-Key safe to retry. The first response to a key is stored for
+// the idempotency window and replayed, with Idempotent-Replayed set, to
+// retries of the same request: the same method, URL and body. Keys are
+// scoped to the Authorization header, so clients never see each other's
+// responses. Reusing a key for another request answers 422, and retrying
+// while the first request is still running 409. Server errors are not
+// stored, so that a retry runs the request again.
+func (s *Server) idempotencyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if key == "" || (r.Method != http.MethodPost && r.Method != http.MethodPatch) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// The key is a structured field string, which clients may also send
+		// without its quotes
+		key = strings.Trim(key, `"`)
+		if key == "" || len(key) > maxIdempotencyKeyLength {
+			errors.WriteError(w, errors.NewBadRequestError(fmt.Sprintf("%s must be between 1 and %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength)))
+			return
+		}
+
+		id := idempotencyRecordID(r.Header.Get("Authorization"), key)
+		if _, running := s.idempotentRequests.LoadOrStore(id, struct{}{}); running {
+			errors.WriteError(w, errors.NewConflictError("A request with this "+idempotencyKeyHeader+" is still in progress; retry once it has finished"))
+			return
+		}
+		defer s.idempotentRequests.Delete(id)
+
+		ctx := r.Context()
+		record, err := s.storage.GetIdempotencyRecord(ctx, id)
+		if err != nil && !isNotFound(err) {
+			writeStorageError(w, "Failed to look up the "+idempotencyKeyHeader, err)
+			return
+		}
+		if err == nil && time.Now().Before(record.ExpiresAt) {
+			fingerprint := newFingerprint(r)
+			if _, err := io.Copy(fingerprint, r.Body); err != nil {
+				errors.WriteError(w, errors.NewBadRequestError("Failed to read the request body"))
+				return
+			}
+			if hex.EncodeToString(fingerprint.Sum(nil)) != record.Fingerprint {
+				errors.WriteError(w, errors.NewBadRequestError("The "+idempotencyKeyHeader+" was already used for a different request; use a new key for each request").
+					SetStatusCode(http.StatusUnprocessableEntity))
+				return
+			}
+			replayResponse(w, record)
+			return
+		}
+
+		// The body is fingerprinted as the handler reads it, which keeps
+		// streamed bodies such as imports out of memory
+		fingerprint := newFingerprint(r)
+		body := io.TeeReader(r.Body, fingerprint)
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{body, r.Body}
+
+		rec := &idempotencyRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		if !rec.wroteHeader {
+			rec.WriteHeader(http.StatusOK)
+		}
+
+		// The fingerprint has to cover what the handler left unread. A body
+		// too large to read is not worth keeping a response for.
+		n, err := io.CopyN(io.Discard, body, maxRequestBodyBytes+1)
+		if (err != nil && err != io.EOF) || n > maxRequestBodyBytes {
+			return
+		}
+		if rec.status >= http.StatusInternalServerError {
+			return
+		}
+		if rec.overflow {
+			logger.Warn("Response too large to store for its "+idempotencyKeyHeader, "path", r.URL.Path)
+			return
+		}
+
+		now := time.Now().UTC()
+		record = models.IdempotencyRecord{
+			ID:          id,
+			Fingerprint: hex.EncodeToString(fingerprint.Sum(nil)),
+			StatusCode:  rec.status,
+			Header:      rec.header,
+			Body:        rec.body.Bytes(),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(s.idempotencyWindow()),
+		}
+		if err := s.storage.SaveIdempotencyRecord(context.WithoutCancel(ctx), record); err != nil {
+			logger.Error("Failed to store the response for its "+idempotencyKeyHeader, "error", err)
+		}
+	})
+}
+
+func (s *Server) idempotencyWindow() time.Duration {
+	if s.config.IdempotencyWindow > 0 {
+		return s.config.IdempotencyWindow
+	}
+	return defaultIdempotencyWindow
+}
+
+// StartIdempotencyCleanup deletes expired idempotency records every
+// idempotencyCleanupInterval until ctx is done
+func (s *Server) StartIdempotencyCleanup(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(idempotencyCleanupInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				deleted, err := s.storage.DeleteExpiredIdempotencyRecords(ctx, now)
+				if err != nil {
+					logger.Error("Failed to delete expired idempotency records", "error", err)
+				} else if deleted > 0 {
+					logger.Debug("Deleted expired idempotency records", "count", deleted)
+				}
+			}
+		}
+	}()
+}
+
+// idempotencyRecordID derives a storage-safe ID from the key and the
+// credentials it was sent with
+func idempotencyRecordID(authorization, key string) string {
+	sum := sha256.Sum256([]byte(authorization + "\n" + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// newFingerprint starts the digest of a request with its method and URL,
+// for the body to be written to
+func newFingerprint(r *http.Request) hash.Hash {
+	digest := sha256.New()
+	io.WriteString(digest, r.Method+" "+r.URL.RequestURI()+"\n")
+	return digest
+}
+
+func replayResponse(w http.ResponseWriter, record models.IdempotencyRecord) {
+	header := w.Header()
+	for name, values := range record.Header {
+		header[name] = values
+	}
+	header.Set(idempotentReplayedHeader, "true")
+	w.WriteHeader(record.StatusCode)
+	w.Write(record.Body)
+}
+
+// idempotencyRecorder passes a response through while keeping a copy of it
+// for replay
+type idempotencyRecorder struct {
+	http.ResponseWriter
+
+	wroteHeader bool
+	status      int
+	header      http.Header
+	body        bytes.Buffer
+	overflow    bool
+}
+
+func (rec *idempotencyRecorder) WriteHeader(status int) {
+	if rec.wroteHeader {
+		return
+	}
+	rec.wroteHeader = true
+	rec.status = status
+
+	rec.header = rec.Header().Clone()
+	for _, name := range unreplayedHeaders {
+		delete(rec.header, name)
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *idempotencyRecorder) Write(p []byte) (int, error) {
+	if !rec.wroteHeader {
+		rec.WriteHeader(http.StatusOK)
+	}
+	if !rec.overflow {
+		if rec.body.Len()+len(p) <= maxStoredResponseBytes {
+			rec.body.Write(p)
+		} else {
+			rec.overflow = true
+			rec.body.Reset()
+		}
+	}
+	return rec.ResponseWriter.Write(p)
+}
+
+func (rec *idempotencyRecorder) Flush() {
+	http.NewResponseController(rec.ResponseWriter).Flush()
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (rec *idempotencyRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func postWithKey(s *Server, path, key, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	r.Header.Set("Idempotency-Key", key)
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, r)
+	return rec
+}
+
+func TestIdempotency_ReplaysRetriesOfTheSameRequest(t *testing.T) {
+	s := newTestServer(t)
+	const body = `{"name":"Alpha","description":"first","transport":"stdio","status":"new"}`
+
+	first := postWithKey(s, "/api/servers/v2", "build-42", body)
+	if first.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", first.Code, first.Body)
+	}
+
+	retry := postWithKey(s, "/api/servers/v2", "build-42", body)
+	if retry.Code != http.StatusCreated || retry.Header().Get("Idempotent-Replayed") != "true" {
+		t.Fatalf("expected the 201 to be replayed, got %d %v: %s", retry.Code, retry.Header(), retry.Body)
+	}
+	if retry.Body.String() != first.Body.String() || retry.Header().Get("Location") != first.Header().Get("Location") {
+		t.Errorf("expected the same response, got %s after %s", retry.Body, first.Body)
+	}
+
+	servers, _ := s.storage.ListServers(context.Background())
+	if len(servers) != 1 {
+		t.Errorf("expected the retry not to create another server, got %d servers", len(servers))
+	}
+
+	reused := postWithKey(s, "/api/servers/v2", "build-42", strings.Replace(body, "Alpha", "Bravo", 1))
+	if reused.Code != http.StatusUnprocessableEntity || !strings.Contains(reused.Body.String(), "different request") {
+		t.Errorf("expected a key reused for another body to be rejected, got %d: %s", reused.Code, reused.Body)
+	}
+}
+
+func TestIdempotency_RunsAgainOnceExpired(t *testing.T) {
+	s := newTestServer(t)
+	const body = `{"name":"Alpha","description":"first","transport":"stdio","status":"new"}`
+
+	if rec := postWithKey(s, "/api/servers/v2", "build-42", body); rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body)
+	}
+
+	ctx := context.Background()
+	record, err := s.storage.GetIdempotencyRecord(ctx, idempotencyRecordID("", "build-42"))
+	if err != nil {
+		t.Fatalf("expected the response to be stored: %v", err)
+	}
+	record.ExpiresAt = time.Now().Add(-time.Minute)
+	s.storage.SaveIdempotencyRecord(ctx, record)
+
+	retry := postWithKey(s, "/api/servers/v2", "build-42", body)
+	if retry.Code != http.StatusConflict || retry.Header().Get("Idempotent-Replayed") != "" {
+		t.Errorf("expected the expired key to run the request again and hit the existing slug, got %d: %s", retry.Code, retry.Body)
+	}
+
+	if deleted, err := s.storage.DeleteExpiredIdempotencyRecords(ctx, time.Now()); err != nil || deleted != 0 {
+		t.Errorf("expected the conflict to have been stored afresh, deleted %d (%v)", deleted, err)
+	}
+}
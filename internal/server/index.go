@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"net/url"
+	"slices"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// indexPage is the data behind the home page: the filtered server cards, the
+// facets used to narrow them down and the curated collections
+type indexPage struct {
+	Servers     []models.Server
+	Tags        []facet
+	Categories  []facet
+	Collections []collectionView
+	Filtered    bool
+}
+
+// facet is one selectable value in the faceted navigation
+type facet struct {
+	Slug   string
+	Name   string
+	Count  int
+	Active bool
+	URL    string
+}
+
+// buildIndexPage narrows the catalog down by the tag and category filters in
+// the query and counts how many of the remaining servers fall under each facet
+func (s *Server) buildIndexPage(ctx context.Context, query url.Values) (indexPage, error) {
+	servers, err := s.storage.ListServers(ctx)
+	if err != nil {
+		return indexPage{}, err
+	}
+	s.assessServers(servers)
+	tags, err := s.storage.ListTags(ctx)
+	if err != nil {
+		return indexPage{}, err
+	}
+	categories, err := s.storage.ListCategories(ctx)
+	if err != nil {
+		return indexPage{}, err
+	}
+	collections, err := s.storage.ListCollections(ctx)
+	if err != nil {
+		return indexPage{}, err
+	}
+
+	// Only the facets are honoured on the page; other API filters are ignored
+	selected := url.Values{}
+	for _, param := range []string{"tag", "category"} {
+		if values, ok := query[param]; ok {
+			selected[param] = values
+		}
+	}
+
+	// Selecting more facets narrows the list down, so every value must match
+	matching := servers
+	for param, values := range selected {
+		for _, value := range values {
+			matching = filterServers(matching, url.Values{param: {value}})
+		}
+	}
+
+	page := indexPage{
+		Servers:  matching,
+		Filtered: len(selected) > 0,
+	}
+
+	for _, tag := range tags {
+		page.Tags = appendFacet(page.Tags, selected, "tag", tag.Slug, tag.Name,
+			countServers(page.Servers, func(server models.Server) []string { return server.Tags }, tag.Slug))
+	}
+	for _, category := range categories {
+		page.Categories = appendFacet(page.Categories, selected, "category", category.Slug, category.Name,
+			countServers(page.Servers, func(server models.Server) []string { return server.Categories }, category.Slug))
+	}
+
+	if !page.Filtered {
+		for _, collection := range collections {
+			view := resolveCollection(collection, servers)
+			if len(view.Items) > 0 {
+				page.Collections = append(page.Collections, view)
+			}
+		}
+	}
+
+	return page, nil
+}
+
+// appendFacet adds a facet unless it would select nothing. Its URL toggles
+// the value on or off while keeping the rest of the selection.
+func appendFacet(facets []facet, selected url.Values, param, slug, name string, count int) []facet {
+	active := slices.Contains(selected[param], slug)
+	if count == 0 && !active {
+		return facets
+	}
+
+	query := url.Values{}
+	for key, values := range selected {
+		query[key] = slices.Clone(values)
+	}
+	if active {
+		query[param] = slices.DeleteFunc(query[param], func(value string) bool { return value == slug })
+	} else {
+		query.Add(param, slug)
+	}
+
+	link := "/"
+	if encoded := query.Encode(); encoded != "" {
+		link += "?" + encoded
+	}
+
+	return append(facets, facet{Slug: slug, Name: name, Count: count, Active: active, URL: link})
+}
+
+func countServers(servers []models.Server, terms func(models.Server) []string, slug string) int {
+	count := 0
+	for _, server := range servers {
+		if slices.Contains(terms(server), slug) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/logger"
+)
+
+// maxRequestBodyBytes caps the size of JSON request bodies
+const maxRequestBodyBytes = 1 << 20
+
+// readJSON decodes a JSON request body into v. Oversized bodies, unknown
+// fields and trailing data are all rejected.
+func readJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if stderrors.As(err, &maxBytesErr) {
+			return errors.NewBadRequestError(fmt.Sprintf("Request body exceeds %d bytes", maxBytesErr.Limit)).
+				SetStatusCode(http.StatusRequestEntityTooLarge)
+		}
+		return errors.NewBadRequestError("Invalid JSON body: " + err.Error())
+	}
+
+	if decoder.More() {
+		return errors.NewBadRequestError("Request body must contain a single JSON value")
+	}
+
+	return nil
+}
+
+// writeJSON writes v as the JSON response body with the given status code
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Error("Failed to encode response", "error", err)
+	}
+}
+
+// writeStorageError passes application errors raised by storage straight
+// through and reports anything else as a database error
+func writeStorageError(w http.ResponseWriter, message string, err error) {
+	if _, ok := err.(*errors.AppError); ok {
+		errors.WriteError(w, err)
+		return
+	}
+	errors.WriteError(w, errors.NewDatabaseError(message, err))
+}
@@ -0,0 +1,252 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// Page sizes of the server list
+const (
+	defaultServerPageSize = 100
+	maxServerPageSize     = 500
+)
+
+// serverList is the envelope of the server list. Items are servers in the
+// representation of the API version, limited to the requested fields when
+// the fields parameter is given.
+type serverList struct {
+	Items      []any  `json:"items"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	Total      int    `json:"total"`
+}
+
+// serverSortKeys maps the sort parameter onto a key that orders servers when
+// compared as strings. Prefixing the name with - sorts in descending order.
+var serverSortKeys = map[string]func(models.Server) string{
+	"name":      func(s models.Server) string { return strings.ToLower(s.Name) },
+	"status":    func(s models.Server) string { return s.Status },
+	"createdAt": func(s models.Server) string { return sortableTime(s.CreatedAt) },
+	"updatedAt": func(s models.Server) string { return sortableTime(s.UpdatedAt) },
+	"risk": func(s models.Server) string {
+		if s.RiskAssessment == nil {
+			return ""
+		}
+		return fmt.Sprintf("%05d", s.RiskAssessment.Score)
+	},
+}
+
+// serverListParameters are the list parameters that shape the page rather
+// than filter the servers
+var serverListParameters = []string{"sort", "fields", "limit", "cursor"}
+
+// serverListQuery is the parsed form of the list parameters
+type serverListQuery struct {
+	sort       string
+	descending bool
+	key        func(models.Server) string
+	codec      serverCodec
+	fields     []string
+	limit      int
+	after      *listCursor
+}
+
+// listCursor marks the last server of a page. It carries the sort it was
+// issued for, since it is meaningless under another order.
+type listCursor struct {
+	Sort string `json:"s"`
+	Key  string `json:"k"`
+	ID   string `json:"id"`
+}
+
+// parseServerListQuery validates every parameter of a list request, whose
+// items the codec represents. Unknown parameters are rejected rather than
+// ignored, so a misspelt filter does not silently return everything.
+func parseServerListQuery(query url.Values, codec serverCodec) (serverListQuery, error) {
+	for param, values := range query {
+		if slices.Contains(serverListParameters, param) {
+			if len(values) > 1 {
+				return serverListQuery{}, errors.NewBadRequestError(fmt.Sprintf("Query parameter %q may only be given once", param))
+			}
+			continue
+		}
+		if _, ok := serverFilters[param]; !ok {
+			return serverListQuery{}, errors.NewBadRequestError(fmt.Sprintf("Unknown query parameter %q", param))
+		}
+		if check, ok := filterValueChecks[param]; ok {
+			for _, value := range values {
+				if err := check(value); err != nil {
+					return serverListQuery{}, errors.NewBadRequestError(fmt.Sprintf("Query parameter %q %s", param, err))
+				}
+			}
+		}
+	}
+
+	list := serverListQuery{sort: "name", codec: codec, limit: defaultServerPageSize}
+
+	if sort := query.Get("sort"); sort != "" {
+		list.sort = sort
+	}
+	name, descending := strings.CutPrefix(list.sort, "-")
+	key, ok := serverSortKeys[name]
+	if !ok {
+		return serverListQuery{}, errors.NewBadRequestError(fmt.Sprintf("sort must be one of %s, optionally prefixed with -", strings.Join(sortedMapKeys(serverSortKeys), ", ")))
+	}
+	list.key, list.descending = key, descending
+
+	if fields := query.Get("fields"); fields != "" {
+		known := codec.fieldNames()
+		for _, field := range strings.Split(fields, ",") {
+			field = strings.TrimSpace(field)
+			if !slices.Contains(known, field) {
+				return serverListQuery{}, errors.NewBadRequestError(fmt.Sprintf("Unknown field %q", field))
+			}
+			list.fields = append(list.fields, field)
+		}
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 || n > maxServerPageSize {
+			return serverListQuery{}, errors.NewBadRequestError(fmt.Sprintf("limit must be a number between 1 and %d", maxServerPageSize))
+		}
+		list.limit = n
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		after, err := decodeListCursor(cursor)
+		if err != nil {
+			return serverListQuery{}, errors.NewBadRequestError("Invalid cursor")
+		}
+		if after.Sort != list.sort {
+			return serverListQuery{}, errors.NewBadRequestError("The cursor was issued for another sort order")
+		}
+		list.after = &after
+	}
+
+	return list, nil
+}
+
+// page sorts the matching servers and returns the requested page. Ties are
+// broken by ID so that the order, and therefore the cursor, is stable.
+func (q serverListQuery) page(servers []models.Server) (serverList, error) {
+	compare := func(aKey, aID, bKey, bID string) int {
+		c := strings.Compare(aKey, bKey)
+		if c == 0 {
+			c = strings.Compare(aID, bID)
+		}
+		if q.descending {
+			c = -c
+		}
+		return c
+	}
+	slices.SortFunc(servers, func(a, b models.Server) int {
+		return compare(q.key(a), a.ID, q.key(b), b.ID)
+	})
+
+	list := serverList{Items: []any{}, Total: len(servers)}
+
+	start := 0
+	if q.after != nil {
+		start = len(servers)
+		for i, server := range servers {
+			if compare(q.key(server), server.ID, q.after.Key, q.after.ID) > 0 {
+				start = i
+				break
+			}
+		}
+	}
+
+	end := min(start+q.limit, len(servers))
+	for _, server := range servers[start:end] {
+		item, err := q.project(server)
+		if err != nil {
+			return serverList{}, err
+		}
+		list.Items = append(list.Items, item)
+	}
+
+	if end < len(servers) {
+		last := servers[end-1]
+		list.NextCursor = encodeListCursor(listCursor{Sort: q.sort, Key: q.key(last), ID: last.ID})
+	}
+
+	return list, nil
+}
+
+// project represents a server as the codec does, limited to the requested
+// fields. The ID is always kept so items can be fetched in full.
+func (q serverListQuery) project(server models.Server) (any, error) {
+	item := q.codec.toJSON(server)
+	if len(q.fields) == 0 {
+		return item, nil
+	}
+
+	encoded, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &all); err != nil {
+		return nil, err
+	}
+
+	projected := map[string]json.RawMessage{"id": all["id"]}
+	for _, field := range q.fields {
+		if value, ok := all[field]; ok {
+			projected[field] = value
+		}
+	}
+
+	return projected, nil
+}
+
+// jsonFieldNames lists the JSON properties of a struct type
+func jsonFieldNames(t reflect.Type) []string {
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func encodeListCursor(cursor listCursor) string {
+	encoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+func decodeListCursor(value string) (listCursor, error) {
+	var cursor listCursor
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, err
+	}
+	err = json.Unmarshal(decoded, &cursor)
+	return cursor, err
+}
+
+// sortableTime formats a time so that string order is chronological
+func sortableTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000000000Z")
+}
+
+func sortedMapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func listingFixture() []models.Server {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	servers := []models.Server{}
+	for i := 0; i < 5; i++ {
+		servers = append(servers, models.Server{
+			ID:        fmt.Sprintf("id-%d", i),
+			Name:      fmt.Sprintf("Server %d", i),
+			Status:    models.StatusApproved,
+			CreatedAt: base.Add(time.Duration(i%2) * time.Hour),
+		})
+	}
+	return servers
+}
+
+func TestServerList_RejectsUnknownParameters(t *testing.T) {
+	for _, raw := range []string{
+		"colour=red",
+		"status=enabled",
+		"createdAfter=yesterday",
+		"sort=size",
+		"fields=name,secret",
+		"limit=0",
+		"limit=1&limit=2",
+		"cursor=not-a-cursor",
+	} {
+		query, _ := url.ParseQuery(raw)
+		if _, err := parseServerListQuery(query, serverCodecV1); err == nil {
+			t.Errorf("expected %q to be rejected", raw)
+		}
+	}
+}
+
+// TestServerList_CursorWalksEveryServerOnce pages through servers whose sort
+// keys tie, which the ID must break
+func TestServerList_CursorWalksEveryServerOnce(t *testing.T) {
+	seen := map[string]bool{}
+	cursor := ""
+
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+
+		query := url.Values{"sort": {"-createdAt"}, "limit": {"2"}}
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+		parsed, err := parseServerListQuery(query, serverCodecV1)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		list, err := parsed.page(listingFixture())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if list.Total != 5 {
+			t.Errorf("expected total 5, got %d", list.Total)
+		}
+
+		for _, item := range list.Items {
+			server := item.(models.Server)
+			if seen[server.ID] {
+				t.Errorf("server %s returned twice", server.ID)
+			}
+			seen[server.ID] = true
+		}
+
+		if cursor = list.NextCursor; cursor == "" {
+			break
+		}
+	}
+
+	if len(seen) != 5 {
+		t.Errorf("expected 5 servers, got %d", len(seen))
+	}
+}
+
+func TestServerList_CursorIsBoundToSort(t *testing.T) {
+	parsed, _ := parseServerListQuery(url.Values{"limit": {"1"}}, serverCodecV1)
+	list, _ := parsed.page(listingFixture())
+
+	_, err := parseServerListQuery(url.Values{"sort": {"-name"}, "cursor": {list.NextCursor}}, serverCodecV1)
+	if err == nil {
+		t.Error("expected a cursor issued for another sort to be rejected")
+	}
+}
+
+func TestServerList_SparseFields(t *testing.T) {
+	parsed, err := parseServerListQuery(url.Values{"fields": {"name,status"}, "limit": {"1"}}, serverCodecV1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	list, err := parsed.page(listingFixture())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	item := list.Items[0].(map[string]json.RawMessage)
+	if len(item) != 3 || string(item["id"]) != `"id-0"` || string(item["name"]) != `"Server 0"` {
+		t.Errorf("expected id, name and status only, got %v", item)
+	}
+}
+
+func TestServerList_OwnershipFilters(t *testing.T) {
+	servers := []models.Server{
+		{ID: "platform", Ownership: &models.Ownership{
+			Team:             "Platform",
+			TechnicalContact: &models.Contact{Name: "Ada", Email: "ada@example.com"},
+			Vendor:           &models.Vendor{Name: "Acme", Type: models.VendorInternal},
+			SupportTier:      models.SupportTierCritical,
+		}},
+		{ID: "github", Ownership: &models.Ownership{
+			Team:            "Developer Experience",
+			BusinessContact: &models.Contact{Name: "Grace", Email: "grace@example.com"},
+			Vendor:          &models.Vendor{Name: "GitHub", Type: models.VendorThirdParty},
+			SupportTier:     models.SupportTierBestEffort,
+		}},
+		{ID: "unowned"},
+	}
+
+	tests := map[string][]string{
+		"owner=platform":        {"platform"},
+		"owner=ADA@example.com": {"platform"},
+		"owner=Grace":           {"github"},
+		"owner=nobody":          nil,
+		"vendor=github":         {"github"},
+		"vendorType=internal":   {"platform"},
+		"vendorType=internal&vendorType=third-party": {"platform", "github"},
+		"supportTier=best-effort":                    {"github"},
+		"supportTier=tier-1&vendor=GitHub":           nil,
+	}
+
+	for raw, want := range tests {
+		query, _ := url.ParseQuery(raw)
+		var got []string
+		for _, server := range filterServers(servers, query) {
+			got = append(got, server.ID)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: got %v, want %v", raw, got, want)
+		}
+	}
+}
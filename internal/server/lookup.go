@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// lookupServer finds a server by ID or slug. moved reports that ref is not
+// the server's current slug (an old slug, or a display name from before slugs
+// existed), so callers can redirect to the canonical URL.
+func (s *Server) lookupServer(ctx context.Context, ref string) (server models.Server, moved bool, err error) {
+	server, err = s.storage.GetServer(ctx, ref)
+	if err == nil || !isNotFound(err) {
+		return server, false, err
+	}
+
+	server, err = s.storage.GetServerBySlug(ctx, ref)
+	if err == nil || !isNotFound(err) {
+		return server, err == nil && server.Slug != ref, err
+	}
+
+	// Links from before slugs were introduced used the display name
+	if slug := models.Slugify(ref); slug != "" && slug != ref {
+		server, err = s.storage.GetServerBySlug(ctx, slug)
+		return server, true, err
+	}
+
+	return models.Server{}, false, err
+}
+
+// serverFromRequest resolves the {server} wildcard of the request. When the
+// request used an outdated slug it answers with a permanent redirect to the
+// canonical URL and returns false, as it does after writing an error.
+func (s *Server) serverFromRequest(w http.ResponseWriter, r *http.Request, redirectStatus int) (models.Server, bool) {
+	server, moved, err := s.lookupServer(r.Context(), r.PathValue("server"))
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve server", err)
+		return models.Server{}, false
+	}
+
+	if moved {
+		http.Redirect(w, r, canonicalServerPath(r, server.Slug), redirectStatus)
+		return models.Server{}, false
+	}
+
+	return server, true
+}
+
+// canonicalServerPath rebuilds the request URL from its route pattern with
+// the {server} wildcard replaced by the given slug
+func canonicalServerPath(r *http.Request, slug string) string {
+	pattern := r.Pattern
+	if _, path, found := strings.Cut(pattern, " "); found {
+		pattern = path
+	}
+
+	segments := strings.Split(pattern, "/")
+	for i, segment := range segments {
+		name, isWildcard := strings.CutPrefix(segment, "{")
+		if !isWildcard {
+			continue
+		}
+		name = strings.TrimSuffix(name, "}")
+
+		if name == "server" {
+			segments[i] = url.PathEscape(slug)
+		} else {
+			segments[i] = url.PathEscape(r.PathValue(name))
+		}
+	}
+
+	location := strings.Join(segments, "/")
+	if r.URL.RawQuery != "" {
+		location += "?" + r.URL.RawQuery
+	}
+
+	return location
+}
+
+func isNotFound(err error) bool {
+	appErr, ok := err.(*errors.AppError)
+	return ok && appErr.Type == errors.ErrorTypeNotFound
+}
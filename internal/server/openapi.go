@@ -0,0 +1,709 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/clientconfig"
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/events"
+	"github.com/bear-belly/mcp-registry/internal/mcpregistry"
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/openapi"
+	"github.com/bear-belly/mcp-registry/internal/patch"
+	"github.com/bear-belly/mcp-registry/internal/webhooks"
+)
+
+// apiRoute is an API route as registered on the mux
+type apiRoute struct {
+	Method string
+	Path   string
+	Admin  bool
+}
+
+// apiOperation documents an API route for the OpenAPI document. Request and
+// Response hold a value of the body type, or nil when there is no body.
+type apiOperation struct {
+	ID          string
+	Tag         string
+	Summary     string
+	Description string
+	Query       []openapi.Parameter
+	Header      []openapi.Parameter
+	Request     any
+	Status      int
+	Response    any
+	Errors      []int
+
+	// RequestType and ResponseType replace application/json as the media
+	// type of the bodies derived from Request and Response. For streams of
+	// JSON values, Request and Response hold a single value of the stream.
+	RequestType  string
+	ResponseType string
+
+	// RequestContent and ResponseContent replace the JSON body derived from
+	// Request and Response for routes that exchange other media types
+	RequestContent  map[string]openapi.MediaType
+	ResponseContent map[string]openapi.MediaType
+
+	// Deprecated marks the operations of deprecated API versions. It is set
+	// when the document is built, from the configured schedule of version,
+	// the server API version of the operation.
+	Deprecated bool
+	version    *apiVersion
+}
+
+// apiTags groups the operations in the document and the explorer
+var apiTags = []openapi.Tag{
+	{Name: "servers", Description: "The server catalog"},
+	{Name: "attachments", Description: "Evidence files attached to servers"},
+	{Name: "taxonomy", Description: "Managed tags, categories and curated collections"},
+	{Name: "profiles", Description: "Bundles of approved servers installed together"},
+	{Name: "events", Description: "A live feed of catalog changes"},
+	{Name: "webhooks", Description: "Outbound webhooks notified of catalog events, and their delivery logs"},
+	{Name: "graphql", Description: "A GraphQL API over servers, their versions, tools and owners, and tags"},
+	{Name: "registry", Description: "The read API of the community MCP Registry, serving approved servers"},
+	{Name: "meta", Description: "Documents describing the API itself"},
+}
+
+// pathParameters describes the wildcards used in API route patterns
+var pathParameters = map[string]string{
+	"server":     "Server ID or slug. Outdated slugs answer with a 308 redirect to the current one.",
+	"attachment": "Attachment ID",
+	"slug":       "Slug of the tag, collection or profile",
+	"host":       "MCP host application: " + strings.Join(clientconfig.HostIDs(), ", "),
+	"id":         "Server ID",
+	"webhook":    "Webhook ID",
+	"delivery":   "Delivery ID",
+}
+
+// serverFilterParameters documents the filters accepted by the server list.
+// Repeating a parameter matches any of its values.
+var serverFilterParameters = []openapi.Parameter{
+	queryParam("status", "Status", models.Statuses...),
+	queryParam("transport", "Transport", models.Transports...),
+	queryParam("q", "Text to find in the name, slug, description or tags, ignoring case"),
+	{Name: "createdAfter", In: openapi.InQuery, Description: "Only servers created after this time", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+	{Name: "updatedSince", In: openapi.InQuery, Description: "Only servers updated at or after this time", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+	queryParam("owner", "Owning team, or technical or business contact name or email"),
+	queryParam("vendor", "Vendor name"),
+	queryParam("vendorType", "Vendor type", models.VendorTypes...),
+	queryParam("supportTier", "Support tier", models.SupportTiers...),
+	queryParam("tag", "Tag slug"),
+	queryParam("category", "Category slug"),
+	queryParam("risk", "Risk level"),
+	{Name: "minRisk", In: openapi.InQuery, Description: "Lowest risk score to include, from 0 to 100", Schema: openapi.IntegerRange(0, 100)},
+	{Name: "maxRisk", In: openapi.InQuery, Description: "Highest risk score to include, from 0 to 100", Schema: openapi.IntegerRange(0, 100)},
+}
+
+// serverListPageParameters documents the parameters that sort and page the
+// server list
+var serverListPageParameters = []openapi.Parameter{
+	queryParam("sort", "Sort order. Prefix with - to reverse. Defaults to name.", "name", "-name", "status", "-status", "createdAt", "-createdAt", "updatedAt", "-updatedAt", "risk", "-risk"),
+	queryParam("fields", "Comma-separated server properties to return. The id is always included."),
+	{Name: "limit", In: openapi.InQuery, Description: "Page size. Defaults to 100.", Schema: openapi.IntegerRange(1, maxServerPageSize)},
+	queryParam("cursor", "nextCursor of the previous page"),
+}
+
+// apiOperations documents every API route. The server API is documented
+// once, under v1, and versionedOperations derives the other versions.
+var apiOperations = versionedOperations(map[string]apiOperation{
+	"GET /api/servers/v1": {
+		ID: "listServers", Tag: "servers", Summary: "List servers",
+		Description: "Unknown query parameters are rejected. Filters are combined with AND.",
+		Query:       slices.Concat(serverFilterParameters, serverListPageParameters),
+		Response:    serverList{}, Errors: []int{http.StatusBadRequest},
+	},
+	"POST /api/servers/v1": {
+		ID: "createServer", Tag: "servers", Summary: "Create a server",
+		Description: "The registry assigns the ID unless one is supplied and derives the slug from the name.",
+		Request:     models.Server{}, Status: http.StatusCreated, Response: models.Server{},
+		Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusRequestEntityTooLarge},
+	},
+	"GET /api/servers/v1:export": {
+		ID: "exportServers", Tag: "servers", Summary: "Export every server as NDJSON",
+		Description: "Streams one server per line in the stored form, which the import accepts.",
+		Response:    models.Server{}, ResponseType: ndjsonContentType,
+	},
+	"POST /api/servers/v1:import": {
+		ID: "importServers", Tag: "servers", Summary: "Import servers from NDJSON",
+		Description: "Reads one server per line and streams back one result per line, followed by a summary line. " +
+			"Existing servers are matched by ID, or else by the slug of their name, and handled by the conflict mode: " +
+			"fail stops the import, skip leaves them alone and upsert replaces them. Lines imported before a stop are kept.",
+		Query: []openapi.Parameter{
+			queryParam("conflict", "What to do with servers that already exist. Defaults to fail.", conflictModes...),
+			queryParam("dryRun", "Check every line without storing anything", "true", "false"),
+		},
+		Request: models.Server{}, RequestType: ndjsonContentType,
+		Response: importResult{}, ResponseType: ndjsonContentType,
+		Errors: []int{http.StatusBadRequest},
+	},
+	"GET /api/servers/v1/{server}": {
+		ID: "getServer", Tag: "servers", Summary: "Get a server",
+		Response: models.Server{}, Errors: []int{http.StatusNotFound},
+	},
+	"PUT /api/servers/v1/{server}": {
+		ID: "replaceServer", Tag: "servers", Summary: "Replace a server",
+		Description: "The ID, creation time and attachments are kept. Renaming moves the server to a new slug and keeps the old one as a redirect.",
+		Header:      []openapi.Parameter{ifMatchParameter},
+		Request:     models.Server{}, Response: models.Server{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusPreconditionFailed, http.StatusRequestEntityTooLarge},
+	},
+	"PATCH /api/servers/v1/{server}": {
+		ID: "patchServer", Tag: "servers", Summary: "Patch a server",
+		Description:    "Accepts a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902). The patch is applied as a whole, after any patch to the same server still in progress, and the result is validated like a replacement. A failed test operation answers 409.",
+		Header:         []openapi.Parameter{ifMatchParameter},
+		RequestContent: serverPatchContent("Server"), Response: models.Server{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusPreconditionFailed, http.StatusRequestEntityTooLarge,
+			http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity},
+	},
+	"DELETE /api/servers/v1/{server}": {
+		ID: "deleteServer", Tag: "servers", Summary: "Delete a server",
+		Description: "Servers that other servers or collections refer to cannot be deleted.",
+		Status:      http.StatusNoContent, Errors: []int{http.StatusNotFound, http.StatusConflict},
+	},
+	"POST /api/servers/v1/{server}/status": {
+		ID: "changeServerStatus", Tag: "servers", Summary: "Change the status of a server",
+		Description: "Moves the server through the approval workflow. Approval requires a license that satisfies the license policy.",
+		Request:     statusChange{}, Response: models.Server{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+	"GET /api/servers/v1/{server}/tools": {
+		ID: "listServerCapabilities", Tag: "servers", Summary: "List the tools, prompts and resource templates of a server",
+		Response: capabilitiesResponse{}, Errors: []int{http.StatusNotFound},
+	},
+	"GET /api/servers/v1/{server}/client-configs": {
+		ID: "listClientConfigs", Tag: "servers", Summary: "Render the server's configuration for every MCP host",
+		Description: "String values keep their ${input:name} placeholders. VS Code prompts for them through its inputs section; for the other hosts they are listed under placeholders.",
+		Response:    []clientconfig.Document{}, Errors: []int{http.StatusNotFound},
+	},
+	"GET /api/servers/v1/{server}/client-configs/{host}": {
+		ID: "getClientConfig", Tag: "servers", Summary: "Render the server's configuration for one MCP host",
+		Response: clientconfig.Document{}, Errors: []int{http.StatusNotFound},
+	},
+	"GET /api/relationships/v1": {
+		ID: "getRelationshipGraph", Tag: "servers", Summary: "Get the relationship graph",
+		Query:    []openapi.Parameter{queryParam("server", "Only return the links of this server, by ID or slug")},
+		Response: relationshipGraph{}, Errors: []int{http.StatusNotFound},
+	},
+	"GET /api/servers/v1/{server}/attachments": {
+		ID: "listAttachments", Tag: "attachments", Summary: "List the attachments of a server",
+		Response: []models.Attachment{}, Errors: []int{http.StatusNotFound},
+	},
+	"POST /api/servers/v1/{server}/attachments": {
+		ID: "uploadAttachment", Tag: "attachments", Summary: "Attach a file to a server",
+		Description:    "The media type is sniffed from the content. PDF, plain text, XML, ZIP, PNG and JPEG files are accepted.",
+		RequestContent: attachmentUploadContent, Status: http.StatusCreated, Response: models.Attachment{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType},
+	},
+	"GET /api/servers/v1/{server}/attachments/{attachment}": {
+		ID: "downloadAttachment", Tag: "attachments", Summary: "Download an attachment",
+		ResponseContent: map[string]openapi.MediaType{
+			"application/octet-stream": {Schema: &openapi.Schema{Type: "string", ContentMediaType: "application/octet-stream"}},
+		},
+		Errors: []int{http.StatusNotFound},
+	},
+	"DELETE /api/servers/v1/{server}/attachments/{attachment}": {
+		ID: "deleteAttachment", Tag: "attachments", Summary: "Delete an attachment",
+		Status: http.StatusNoContent, Errors: []int{http.StatusNotFound},
+	},
+	"GET /api/tags/v1": {
+		ID: "listTags", Tag: "taxonomy", Summary: "List tags", Response: []models.Tag{},
+	},
+	"POST /api/tags/v1": {
+		ID: "createTag", Tag: "taxonomy", Summary: "Create a tag",
+		Request: models.Tag{}, Status: http.StatusCreated, Response: models.Tag{},
+		Errors: []int{http.StatusBadRequest, http.StatusConflict},
+	},
+	"PUT /api/tags/v1/{slug}": {
+		ID: "updateTag", Tag: "taxonomy", Summary: "Rename or describe a tag",
+		Description: "Changing the slug retags every server that uses the tag. Nothing changes if any of those servers no longer passes validation.",
+		Request:     models.Tag{}, Response: models.Tag{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+	"POST /api/tags/v1/{slug}/merge": {
+		ID: "mergeTags", Tag: "taxonomy", Summary: "Merge tags into this tag",
+		Description: "Nothing changes if any server carrying a merged tag no longer passes validation.",
+		Request:     mergeTagsRequest{}, Response: mergeTagsResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict},
+	},
+	"GET /api/categories/v1": {
+		ID: "listCategories", Tag: "taxonomy", Summary: "List categories", Response: []models.Category{},
+	},
+	"POST /api/categories/v1": {
+		ID: "createCategory", Tag: "taxonomy", Summary: "Create a category",
+		Request: models.Category{}, Status: http.StatusCreated, Response: models.Category{},
+		Errors: []int{http.StatusBadRequest, http.StatusConflict},
+	},
+	"GET /api/collections/v1": {
+		ID: "listCollections", Tag: "taxonomy", Summary: "List collections", Response: []models.Collection{},
+	},
+	"GET /api/collections/v1/{slug}": {
+		ID: "getCollection", Tag: "taxonomy", Summary: "Get a collection with its servers",
+		Response: collectionView{}, Errors: []int{http.StatusNotFound},
+	},
+	"POST /api/collections/v1": {
+		ID: "createCollection", Tag: "taxonomy", Summary: "Create a collection",
+		Description: "Servers may be listed by ID or slug and are stored by ID.",
+		Request:     models.Collection{}, Status: http.StatusCreated, Response: models.Collection{},
+		Errors: []int{http.StatusBadRequest, http.StatusConflict},
+	},
+	"PUT /api/collections/v1/{slug}": {
+		ID: "updateCollection", Tag: "taxonomy", Summary: "Replace a collection",
+		Request: models.Collection{}, Response: models.Collection{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"DELETE /api/collections/v1/{slug}": {
+		ID: "deleteCollection", Tag: "taxonomy", Summary: "Delete a collection",
+		Status: http.StatusNoContent, Errors: []int{http.StatusNotFound},
+	},
+	"GET /api/profiles/v1": {
+		ID: "listProfiles", Tag: "profiles", Summary: "List profiles", Response: []models.Profile{},
+	},
+	"GET /api/profiles/v1/{slug}": {
+		ID: "getProfile", Tag: "profiles", Summary: "Get a profile with its servers",
+		Response: profileView{}, Errors: []int{http.StatusNotFound},
+	},
+	"GET /api/profiles/v1/{slug}/client-configs/{host}": {
+		ID: "getProfileClientConfig", Tag: "profiles", Summary: "Render a profile's servers into one configuration for an MCP host",
+		Description: "Entry and input names used by more than one server are qualified with the server's slug and listed under renamed. Servers that are no longer approved are left out and listed under omitted.",
+		Response:    profileClientConfig{}, Errors: []int{http.StatusNotFound},
+	},
+	"POST /api/profiles/v1": {
+		ID: "createProfile", Tag: "profiles", Summary: "Create a profile",
+		Description: "Servers may be given by ID or slug and are stored as IDs. Only approved servers may be bundled. The slug defaults to the slug of the name.",
+		Request:     models.Profile{}, Status: http.StatusCreated, Response: models.Profile{},
+		Errors: []int{http.StatusBadRequest, http.StatusConflict},
+	},
+	"PUT /api/profiles/v1/{slug}": {
+		ID: "updateProfile", Tag: "profiles", Summary: "Replace a profile",
+		Request: models.Profile{}, Response: models.Profile{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"DELETE /api/profiles/v1/{slug}": {
+		ID: "deleteProfile", Tag: "profiles", Summary: "Delete a profile",
+		Status: http.StatusNoContent, Errors: []int{http.StatusNotFound},
+	},
+	"GET /api/events": {
+		ID: "streamEvents", Tag: "events", Summary: "Stream catalog changes as Server-Sent Events",
+		Description: "Each event has an id, its type as the event name and the event below as its data. Reconnecting with the Last-Event-ID header, or the lastEventId parameter, replays the events since that ID from a buffer of the last " + strconv.Itoa(eventReplaySize) + ". When they are no longer buffered the stream starts with a " + eventStreamReset + " event, after which the client should reload the server list.",
+		Query: []openapi.Parameter{
+			queryParam("types", "Comma-separated event types to receive: "+strings.Join(events.Types, ", ")+". Defaults to all."),
+			queryParam("lastEventId", "ID of the last event received, for clients that cannot send Last-Event-ID"),
+		},
+		Response: events.Event{}, ResponseType: eventStreamContentType, Errors: []int{http.StatusBadRequest},
+	},
+	"GET /api/webhooks/v1": {
+		ID: "listWebhooks", Tag: "webhooks", Summary: "List webhooks",
+		Description: "Secrets are never included.",
+		Response:    []models.Webhook{},
+	},
+	"POST /api/webhooks/v1": {
+		ID: "createWebhook", Tag: "webhooks", Summary: "Register a webhook",
+		Description: "Events are delivered as a signed JSON POST to the URL. X-Webhook-Signature is sha256= followed by the hex HMAC-SHA256, keyed with the secret, of X-Webhook-Timestamp, a dot and the body. A secret is generated when none is given, and this response is the only one that includes it. Failed deliveries are retried with exponential backoff before they are dead-lettered. Should the registry fall too far behind to deliver some events, every webhook is sent a " + webhooks.EventsMissedEvent + " event instead, after which receivers should resynchronise.",
+		Request:     models.Webhook{}, Status: http.StatusCreated, Response: models.Webhook{},
+		Errors: []int{http.StatusBadRequest},
+	},
+	"GET /api/webhooks/v1/{webhook}": {
+		ID: "getWebhook", Tag: "webhooks", Summary: "Get a webhook",
+		Response: models.Webhook{}, Errors: []int{http.StatusNotFound},
+	},
+	"PUT /api/webhooks/v1/{webhook}": {
+		ID: "updateWebhook", Tag: "webhooks", Summary: "Replace a webhook",
+		Description: "The secret is kept unless a new one is given.",
+		Request:     models.Webhook{}, Response: models.Webhook{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"DELETE /api/webhooks/v1/{webhook}": {
+		ID: "deleteWebhook", Tag: "webhooks", Summary: "Delete a webhook and its delivery log",
+		Status: http.StatusNoContent, Errors: []int{http.StatusNotFound},
+	},
+	"POST /api/webhooks/v1/{webhook}/ping": {
+		ID: "pingWebhook", Tag: "webhooks", Summary: "Send a " + webhooks.PingEvent + " test event",
+		Description: "Answers with the delivery after its first attempt, which shows how the receiver responded.",
+		Response:    models.Delivery{}, Errors: []int{http.StatusNotFound},
+	},
+	"GET /api/webhooks/v1/{webhook}/deliveries": {
+		ID: "listDeliveries", Tag: "webhooks", Summary: "List a webhook's deliveries, newest first",
+		Query:    []openapi.Parameter{queryParam("status", "Only deliveries in this state", models.DeliveryStatuses...)},
+		Response: []models.Delivery{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"GET /api/webhooks/v1/{webhook}/deliveries/{delivery}": {
+		ID: "getDelivery", Tag: "webhooks", Summary: "Get a delivery with its attempts",
+		Response: models.Delivery{}, Errors: []int{http.StatusNotFound},
+	},
+	"POST /api/webhooks/v1/{webhook}/deliveries/{delivery}/replay": {
+		ID: "replayDelivery", Tag: "webhooks", Summary: "Send a delivery's payload again",
+		Description: "The payload is sent as a new delivery with attempts of its own. Answers with it after its first attempt. Pending deliveries cannot be replayed.",
+		Status:      http.StatusCreated, Response: models.Delivery{},
+		Errors: []int{http.StatusNotFound, http.StatusConflict},
+	},
+	"GET /api/graphql": {
+		ID: "queryGraphQL", Tag: "graphql", Summary: "Run a GraphQL query",
+		Description: graphQLDescription + " Mutations must be sent with POST.",
+		Query: []openapi.Parameter{
+			queryParam("query", "The GraphQL document"),
+			queryParam("operationName", "Operation to run when the document has several"),
+			queryParam("variables", "Variables as a JSON object"),
+		},
+		ResponseContent: openapi.JSON(&openapi.Schema{Type: "object"}),
+		Errors:          []int{http.StatusBadRequest, http.StatusMethodNotAllowed},
+	},
+	"POST /api/graphql": {
+		ID: "postGraphQL", Tag: "graphql", Summary: "Run a GraphQL query or mutation",
+		Description: graphQLDescription + " The changeServerStatus mutation requires the admin token.",
+		Request:     graphQLRequest{}, ResponseContent: openapi.JSON(&openapi.Schema{Type: "object"}),
+		Errors: []int{http.StatusBadRequest, http.StatusRequestEntityTooLarge},
+	},
+	"GET /v0/servers": {
+		ID: "listRegistryServers", Tag: "registry", Summary: "List approved servers in the MCP Registry format",
+		Query: []openapi.Parameter{
+			queryParam("cursor", "Cursor returned as nextCursor by the previous page"),
+			queryParam("limit", "Page size, 1 to 100. Defaults to 30."),
+			queryParam("search", "Only servers whose name contains this text"),
+			queryParam("updated_since", "Only servers updated at or after this RFC 3339 timestamp"),
+		},
+		Response: mcpregistry.ServerList{}, Errors: []int{http.StatusBadRequest},
+	},
+	"GET /v0/servers/{id}": {
+		ID: "getRegistryServer", Tag: "registry", Summary: "Get an approved server in the MCP Registry format",
+		Query:    []openapi.Parameter{queryParam("version", "Version to return. Defaults to the latest.")},
+		Response: mcpregistry.ServerJSON{}, Errors: []int{http.StatusNotFound},
+	},
+	"GET /v0/servers/{id}/versions": {
+		ID: "listRegistryServerVersions", Tag: "registry", Summary: "List the approved versions of a server",
+		Response: mcpregistry.ServerList{}, Errors: []int{http.StatusNotFound},
+	},
+	"GET /api/versions": {
+		ID: "listAPIVersions", Tag: "meta", Summary: "List the versions of the server API",
+		Description: "Deprecated versions answer with Deprecation and Sunset headers and a successor-version link.",
+		Response:    []apiVersionStatus{},
+	},
+	"GET /api/openapi.json": {
+		ID: "getOpenAPI", Tag: "meta", Summary: "Get this OpenAPI document",
+		ResponseContent: openapi.JSON(&openapi.Schema{Type: "object"}),
+	},
+})
+
+// versionedOperations documents the server API routes of every version from
+// their v1 operations. The operations of later versions exchange their own
+// representation of servers and take the version as a suffix of their ID,
+// e.g. getServerV2.
+func versionedOperations(operations map[string]apiOperation) map[string]apiOperation {
+	documented := make(map[string]apiOperation, len(operations))
+	for key, operation := range operations {
+		method, path, _ := strings.Cut(key, " ")
+		rest, ok := strings.CutPrefix(path, apiV1.serversPath())
+		if !ok {
+			documented[key] = operation
+			continue
+		}
+
+		for _, version := range apiVersions {
+			versioned := operation
+			if version != apiV1 {
+				versioned.ID += strings.ToUpper(version.Name)
+				versioned.Request = version.documentedShape(operation.Request)
+				versioned.Response = version.documentedShape(operation.Response)
+				if _, ok := operation.RequestContent[patch.MergePatchType]; ok {
+					versioned.RequestContent = serverPatchContent(schemaNameOf(version.serverShape))
+				}
+			}
+			versioned.version = version
+			documented[method+" "+version.serversPath()+rest] = versioned
+		}
+	}
+	return documented
+}
+
+// documentedShape swaps the v1 server and server list for this version's
+func (v *apiVersion) documentedShape(value any) any {
+	switch value.(type) {
+	case models.Server:
+		return v.serverShape
+	case serverList:
+		return v.listShape
+	}
+	return value
+}
+
+func (v *apiVersion) deprecationNote(deprecated, sunset time.Time) string {
+	note := "Deprecated as of " + deprecated.Format(time.DateOnly)
+	if !sunset.IsZero() {
+		note += " and due to be removed on " + sunset.Format(time.DateOnly)
+	}
+	if v.Successor != "" {
+		note += "; use " + v.Successor + " instead"
+	}
+	return note + "."
+}
+
+// schemaNameOf is the component name the schema generator gives the type
+// of v
+func schemaNameOf(v any) string {
+	name := reflect.TypeOf(v).Name()
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// graphQLDescription introduces both GraphQL routes
+var graphQLDescription = "The schema can be introspected. Server lists are cursor connections taking the filters of the server list as arguments. " +
+	"Queries estimated to cost more than " + strconv.Itoa(maxGraphQLComplexity) + " fields, counting the fields under a list once per item it may hold, are rejected. " +
+	"Errors in the query are reported in the errors of a 200 response."
+
+// ServeOpenAPI returns the OpenAPI document describing every API route
+func (s *Server) ServeOpenAPI(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.openAPIDocument())
+}
+
+// openAPIDocument builds the document from the routes registered on the mux,
+// so it cannot list a route that does not exist
+func (s *Server) openAPIDocument() openapi.Document {
+	gen := newSchemaGenerator()
+	gen.Schema(models.Server{})
+	gen.Schema(errors.ErrorResponse{})
+
+	doc := openapi.Document{
+		OpenAPI: openapi.Version,
+		Info: openapi.Info{
+			Title:       "MCP Registry API",
+			Version:     "1.0.0",
+			Description: "Catalog of the MCP servers approved for use in the organisation.",
+		},
+		Tags:  apiTags,
+		Paths: map[string]*openapi.PathItem{},
+		Components: openapi.Components{
+			SecuritySchemes: map[string]openapi.SecurityScheme{
+				"adminToken": {Type: "http", Scheme: "bearer", Description: "The registry admin token"},
+			},
+		},
+	}
+
+	for _, route := range s.apiRoutes {
+		documented, ok := apiOperations[route.Method+" "+route.Path]
+		if !ok {
+			continue
+		}
+
+		item := doc.Paths[route.Path]
+		if item == nil {
+			item = &openapi.PathItem{}
+			doc.Paths[route.Path] = item
+		}
+		if version := documented.version; version != nil {
+			if deprecated, sunset := version.deprecation(s.config); !deprecated.IsZero() {
+				documented.Deprecated = true
+				documented.Description = strings.TrimSpace(documented.Description + " " + version.deprecationNote(deprecated, sunset))
+			}
+		}
+		item.SetOperation(route.Method, buildOperation(gen, route, documented))
+	}
+
+	doc.Components.Schemas = gen.Schemas()
+	return doc
+}
+
+func buildOperation(gen *openapi.Generator, route apiRoute, documented apiOperation) *openapi.Operation {
+	operation := &openapi.Operation{
+		OperationID: documented.ID,
+		Summary:     documented.Summary,
+		Description: documented.Description,
+		Tags:        []string{documented.Tag},
+		Responses:   map[string]openapi.Response{},
+		Deprecated:  documented.Deprecated,
+	}
+
+	for _, name := range routeWildcards(route.Path) {
+		operation.Parameters = append(operation.Parameters, openapi.Parameter{
+			Name: name, In: openapi.InPath, Required: true,
+			Description: pathParameters[name], Schema: &openapi.Schema{Type: "string"},
+		})
+	}
+	operation.Parameters = append(operation.Parameters, documented.Query...)
+	operation.Parameters = append(operation.Parameters, documented.Header...)
+	idempotent := route.Method == http.MethodPost || route.Method == http.MethodPatch
+	if idempotent {
+		operation.Parameters = append(operation.Parameters, idempotencyKeyParameter)
+	}
+
+	switch {
+	case documented.RequestContent != nil:
+		operation.RequestBody = &openapi.RequestBody{Required: true, Content: documented.RequestContent}
+	case documented.Request != nil:
+		operation.RequestBody = &openapi.RequestBody{Required: true, Content: content(documented.RequestType, gen.Schema(documented.Request))}
+	}
+
+	status := documented.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := openapi.Response{Description: http.StatusText(status)}
+	switch {
+	case documented.ResponseContent != nil:
+		success.Content = documented.ResponseContent
+	case documented.Response != nil:
+		success.Content = content(documented.ResponseType, gen.Schema(documented.Response))
+	}
+	operation.Responses[fmt.Sprint(status)] = success
+
+	errorStatuses := slices.Clone(documented.Errors)
+	if idempotent {
+		// A retry while the first request is running conflicts, and a key
+		// reused for another request is unprocessable
+		errorStatuses = append(errorStatuses, http.StatusConflict, http.StatusUnprocessableEntity)
+	}
+	if route.Admin {
+		operation.Security = []map[string][]string{{"adminToken": {}}}
+		errorStatuses = append(errorStatuses, http.StatusUnauthorized)
+	}
+	errorStatuses = append(errorStatuses, http.StatusInternalServerError)
+	for _, code := range errorStatuses {
+		operation.Responses[fmt.Sprint(code)] = openapi.Response{
+			Description: http.StatusText(code),
+			Content:     openapi.JSON(openapi.Ref("ErrorResponse")),
+		}
+	}
+
+	return operation
+}
+
+// ifMatchParameter documents the If-Match of the routes that change a server
+var ifMatchParameter = openapi.Parameter{
+	Name: "If-Match", In: openapi.InHeader,
+	Description: "ETag of the server as last read. When the server has changed since, nothing is written and the request answers 412.",
+	Schema:      &openapi.Schema{Type: "string"},
+}
+
+// idempotencyKeyParameter documents the Idempotency-Key of POST and PATCH
+var idempotencyKeyParameter = openapi.Parameter{
+	Name: idempotencyKeyHeader, In: openapi.InHeader,
+	Description: "Unique key, of up to " + strconv.Itoa(maxIdempotencyKeyLength) + " characters, that makes the request safe to retry. The first response is replayed, with Idempotent-Replayed: true, " +
+		"to retries with the same key, method, URL and body for " + defaultIdempotencyWindow.String() + " by default. " +
+		"Reusing the key for another request answers 422; retrying while the first request is in progress answers 409.",
+	Schema: &openapi.Schema{Type: "string"},
+}
+
+// content returns a body of the given media type, application/json when it
+// is empty
+func content(mediaType string, schema *openapi.Schema) map[string]openapi.MediaType {
+	if mediaType == "" {
+		return openapi.JSON(schema)
+	}
+	return map[string]openapi.MediaType{mediaType: {Schema: schema}}
+}
+
+// newSchemaGenerator returns a generator that knows the vocabularies and
+// server-maintained fields of the models
+func newSchemaGenerator() *openapi.Generator {
+	gen := openapi.NewGenerator()
+
+	for _, field := range []string{"id", "slug", "previousSlugs", "createdAt", "updatedAt", "attachments", "riskAssessment", "licenseDecision"} {
+		gen.Override(models.Server{}, field, openapi.ReadOnly)
+	}
+	gen.Override(models.Server{}, "status", openapi.Enum(models.Statuses))
+	gen.Override(models.Server{}, "transport", openapi.Enum(models.Transports),
+		openapi.Describe("Matched case-insensitively"))
+	gen.Override(models.Server{}, "version", openapi.Describe("Version of the server software. The last record of each version is kept as history."))
+	gen.Override(models.Server{}, "license", openapi.Describe("SPDX license expression, e.g. MIT OR Apache-2.0"))
+	gen.Override(models.Server{}, "licenseDecision", openapi.Enum(models.LicenseDecisions))
+	gen.Override(models.Server{}, "config", openapi.Describe("Client configuration. String values may reference inputs as ${input:name}."))
+	gen.Override(serverList{}, "items", func(schema *openapi.Schema) { schema.Items = openapi.Ref("Server") })
+	gen.Override(serverList{}, "nextCursor", openapi.Describe("Cursor of the next page, absent on the last page"))
+	gen.Override(serverList{}, "total", openapi.Describe("Number of servers matching the filters"))
+	for _, field := range []string{"id", "slug", "previousSlugs", "createdAt", "updatedAt", "attachments"} {
+		gen.Override(serverV2{}, field, openapi.ReadOnly)
+	}
+	gen.Override(serverV2{}, "status", openapi.Enum(models.Statuses))
+	gen.Override(serverV2{}, "transport", openapi.Enum(models.Transports),
+		openapi.Describe("Matched case-insensitively"))
+	gen.Override(serverV2{}, "version", openapi.Describe("Version of the server software. The last record of each version is kept as history."))
+	gen.Override(serverV2{}, "config", openapi.Describe("Client configuration. String values may reference inputs as ${input:name}."))
+	gen.Override(licenseV2{}, "expression", openapi.Describe("SPDX license expression, e.g. MIT OR Apache-2.0"))
+	gen.Override(licenseV2{}, "decision", openapi.Enum(models.LicenseDecisions), openapi.ReadOnly)
+	gen.Override(riskV2{}, "assessment", openapi.ReadOnly)
+	gen.Override(serverListV2{}, "nextCursor", openapi.Describe("Cursor of the next page, absent on the last page"))
+	gen.Override(serverListV2{}, "total", openapi.Describe("Number of servers matching the filters"))
+	gen.Override(apiVersionStatus{}, "deprecated", openapi.Describe("When the version was, or will be, deprecated"))
+	gen.Override(apiVersionStatus{}, "sunset", openapi.Describe("When the version is due to be removed"))
+	gen.Override(apiVersionStatus{}, "requests", openapi.Describe("Requests served since the registry started"))
+	gen.Override(importResult{}, "result", openapi.Enum([]string{importCreated, importUpdated, importSkipped, importFailed}))
+	gen.Override(importResult{}, "summary", openapi.Describe("Only on the last line, which has no other members"))
+	gen.Override(clientconfig.Host{}, "id", openapi.Enum(clientconfig.HostIDs()))
+	gen.Override(clientconfig.Host{}, "file", openapi.Describe("Where the host reads the file from"))
+	gen.Override(statusChange{}, "status", openapi.Enum(models.Statuses))
+	gen.Override(models.Relationship{}, "type", openapi.Enum(models.RelationshipTypes))
+	gen.Override(models.Relationship{}, "target", openapi.Describe("ID of the related server"))
+	gen.Override(models.Attachment{}, "kind", openapi.Enum(models.AttachmentKinds))
+	gen.Override(models.Vendor{}, "type", openapi.Enum(models.VendorTypes))
+	gen.Override(models.Ownership{}, "supportTier", openapi.Enum(models.SupportTiers))
+	gen.Override(models.Risk{}, "dataClassifications", openapi.Enum(models.DataClassifications))
+	gen.Override(models.Risk{}, "networkEgress", openapi.Enum(models.NetworkEgresses))
+	gen.Override(models.Risk{}, "authentication", openapi.Enum(models.AuthenticationModels))
+	gen.Override(models.Risk{}, "hosting", openapi.Enum(models.HostingLocations))
+	gen.Override(models.Collection{}, "servers", openapi.Describe("Server IDs in curated order"))
+	gen.Override(events.Event{}, "type", openapi.Enum(events.Types))
+	gen.Override(models.Webhook{}, "events", openapi.Describe("Event types to deliver, all when empty"))
+	gen.Override(graphQLRequest{}, "extensions", openapi.Describe("Accepted for compatibility with common clients, and ignored"))
+	gen.Override(models.Delivery{}, "status", openapi.Enum(models.DeliveryStatuses))
+	gen.Override(models.Profile{}, "servers", openapi.Describe("IDs of the approved servers in the profile"))
+	gen.Override(clientconfig.Rename{}, "kind", openapi.Enum([]string{clientconfig.RenamedEntry, clientconfig.RenamedInput}))
+
+	return gen
+}
+
+// attachmentUploadContent describes the multipart form of an upload
+var attachmentUploadContent = map[string]openapi.MediaType{
+	"multipart/form-data": {Schema: &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"file": {Type: "string", ContentMediaType: "application/octet-stream"},
+			"kind": {Type: "string", Enum: models.AttachmentKinds},
+			"name": {Type: "string", Description: "Defaults to the uploaded file name"},
+		},
+		Required: []string{"file", "kind"},
+	}},
+}
+
+// serverPatchContent describes the two patch formats of the PATCH endpoint
+// for servers represented by the given schema
+func serverPatchContent(schema string) map[string]openapi.MediaType {
+	return map[string]openapi.MediaType{
+		patch.MergePatchType: {Schema: &openapi.Schema{
+			Ref:         "#/components/schemas/" + schema,
+			Description: "Members to change. null removes a member.",
+		}},
+		patch.JSONPatchType: {Schema: openapi.ArrayOf(&openapi.Schema{
+			Type: "object",
+			Properties: map[string]*openapi.Schema{
+				"op":    {Type: "string", Enum: []string{patch.OpAdd, patch.OpRemove, patch.OpReplace, patch.OpMove, patch.OpCopy, patch.OpTest}},
+				"path":  {Type: "string", Description: "JSON pointer, e.g. /config/github/url"},
+				"from":  {Type: "string", Description: "JSON pointer of the source of move and copy"},
+				"value": {Description: "Value of add, replace and test"},
+			},
+			Required: []string{"op", "path"},
+		})},
+	}
+}
+
+// routeWildcards returns the names of the {wildcards} in a route path
+func routeWildcards(path string) []string {
+	var names []string
+	for _, segment := range strings.Split(path, "/") {
+		if name, ok := strings.CutPrefix(segment, "{"); ok {
+			names = append(names, strings.TrimSuffix(strings.TrimSuffix(name, "}"), "..."))
+		}
+	}
+	return names
+}
+
+func queryParam(name, description string, values ...string) openapi.Parameter {
+	schema := &openapi.Schema{Type: "string"}
+	if len(values) > 0 {
+		schema.Enum = values
+	}
+	return openapi.Parameter{Name: name, In: openapi.InQuery, Description: description, Schema: schema}
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/blob"
+	"github.com/bear-belly/mcp-registry/internal/license"
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/openapi"
+	"github.com/bear-belly/mcp-registry/internal/storage"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("creating blob store: %v", err)
+	}
+	store := storage.NewValidatingStorage(storage.NewFileStorage(t.TempDir()), license.DefaultPolicy())
+
+	s := New(store, blobs, models.Config{MaxAttachmentSize: 1 << 20})
+	s.SetupRoutes()
+	return s
+}
+
+// send serves a request with an optional JSON body through the full handler
+func send(s *Server, method, path, body string) *httptest.ResponseRecorder {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(method, path, reader))
+	return rec
+}
+
+// TestOpenAPI_MatchesRouter checks that every API route is documented, that
+// nothing is documented that is not routed, and that every documented
+// operation reaches the route it describes
+func TestOpenAPI_MatchesRouter(t *testing.T) {
+	s := newTestServer(t)
+	doc := s.openAPIDocument()
+
+	registered := map[string]bool{}
+	for _, route := range s.apiRoutes {
+		key := route.Method + " " + route.Path
+		registered[key] = true
+
+		if _, ok := apiOperations[key]; !ok {
+			t.Errorf("route %s is not documented in apiOperations", key)
+		}
+	}
+	for key := range apiOperations {
+		if !registered[key] {
+			t.Errorf("apiOperations documents %s, which is not routed", key)
+		}
+	}
+
+	documented := 0
+	for path, item := range doc.Paths {
+		for method := range item.Operations() {
+			documented++
+
+			req := httptest.NewRequest(method, strings.NewReplacer("{", "", "}", "").Replace(path), nil)
+			if _, pattern := s.mux.Handler(req); pattern != method+" "+path {
+				t.Errorf("%s %s is routed to %q", method, path, pattern)
+			}
+		}
+	}
+	if documented != len(s.apiRoutes) {
+		t.Errorf("expected %d documented operations, got %d", len(s.apiRoutes), documented)
+	}
+}
+
+func TestOpenAPI_DocumentsServerFilters(t *testing.T) {
+	s := newTestServer(t)
+	list := s.openAPIDocument().Paths["/api/servers/v1"].Get
+
+	params := slices.Concat(sortedMapKeys(serverFilters), serverListParameters)
+	for _, param := range params {
+		found := false
+		for _, parameter := range list.Parameters {
+			found = found || (parameter.Name == param && parameter.In == openapi.InQuery)
+		}
+		if !found {
+			t.Errorf("parameter %q is not documented", param)
+		}
+	}
+}
+
+func TestOpenAPI_ReferencesResolve(t *testing.T) {
+	s := newTestServer(t)
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("document is not valid JSON: %v", err)
+	}
+	if doc["openapi"] != openapi.Version {
+		t.Errorf("expected openapi %s, got %v", openapi.Version, doc["openapi"])
+	}
+
+	schemas := doc["components"].(map[string]any)["schemas"].(map[string]any)
+	for _, name := range []string{"Server", "ErrorResponse"} {
+		if _, ok := schemas[name]; !ok {
+			t.Errorf("expected a %s schema", name)
+		}
+	}
+
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if ref, ok := v["$ref"].(string); ok {
+				if _, exists := schemas[strings.TrimPrefix(ref, "#/components/schemas/")]; !exists {
+					t.Errorf("unresolved reference %s", ref)
+				}
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(doc)
+}
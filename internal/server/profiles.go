@@ -0,0 +1,268 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/clientconfig"
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// profileView is a profile with its servers resolved, in the listed order
+type profileView struct {
+	models.Profile
+	Items []models.Server `json:"items"`
+}
+
+// profileClientConfig is the merged configuration of a profile for one host.
+// Renamed lists the entries and inputs qualified to resolve collisions;
+// Omitted lists profile servers left out because they are no longer approved.
+type profileClientConfig struct {
+	clientconfig.Document
+	Renamed []clientconfig.Rename `json:"renamed,omitempty"`
+	Omitted []string              `json:"omitted,omitempty"`
+}
+
+func (s *Server) setupProfileRoutes() {
+	s.handleAPI("GET /api/profiles/v1", s.ListProfilesV1)
+	s.handleAPI("GET /api/profiles/v1/{slug}", s.GetProfileV1)
+	s.handleAPI("GET /api/profiles/v1/{slug}/client-configs/{host}", s.GetProfileClientConfigV1)
+	s.handleAdminAPI("POST /api/profiles/v1", s.CreateProfileV1)
+	s.handleAdminAPI("PUT /api/profiles/v1/{slug}", s.UpdateProfileV1)
+	s.handleAdminAPI("DELETE /api/profiles/v1/{slug}", s.DeleteProfileV1)
+}
+
+// ListProfilesV1 handles retrieving all profiles
+func (s *Server) ListProfilesV1(w http.ResponseWriter, r *http.Request) {
+	profiles, err := s.storage.ListProfiles(r.Context())
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve profiles", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, profiles)
+}
+
+// GetProfileV1 handles retrieving a profile with its servers resolved
+func (s *Server) GetProfileV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	profile, ok := s.profileFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	servers, err := s.storage.ListServers(ctx)
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve servers", err)
+		return
+	}
+	s.assessServers(servers)
+
+	view := profileView{Profile: profile, Items: []models.Server{}}
+	for _, id := range profile.Servers {
+		if i := slices.IndexFunc(servers, func(server models.Server) bool { return server.ID == id }); i >= 0 {
+			view.Items = append(view.Items, servers[i])
+		}
+	}
+
+	writeJSON(w, http.StatusOK, view)
+}
+
+// GetProfileClientConfigV1 handles rendering every approved server of a
+// profile into a single configuration for one MCP host
+func (s *Server) GetProfileClientConfigV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	host, known := clientconfig.FindHost(r.PathValue("host"))
+	if !known {
+		errors.WriteError(w, errors.NewNotFoundError("Host").
+			SetDetails(map[string][]string{"hosts": clientconfig.HostIDs()}))
+		return
+	}
+
+	profile, ok := s.profileFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var servers []models.Server
+	var omitted []string
+	for _, id := range profile.Servers {
+		server, err := s.storage.GetServer(ctx, id)
+		if isNotFound(err) || (err == nil && server.Status != models.StatusApproved) {
+			omitted = append(omitted, id)
+			continue
+		} else if err != nil {
+			writeStorageError(w, "Failed to retrieve server", err)
+			return
+		}
+		servers = append(servers, server)
+	}
+
+	doc, renamed, err := clientconfig.Merge(host, servers)
+	if err != nil {
+		errors.WriteError(w, errors.NewInternalError("Failed to generate client config", err))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, profileClientConfig{Document: doc, Renamed: renamed, Omitted: omitted})
+}
+
+// CreateProfileV1 handles creating a profile
+func (s *Server) CreateProfileV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var profile models.Profile
+	if err := readJSON(w, r, &profile); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if profile.Slug == "" {
+		profile.Slug = models.Slugify(profile.Name)
+	}
+	if err := s.checkProfile(ctx, &profile); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if _, found, err := s.findProfile(ctx, profile.Slug); err != nil {
+		writeStorageError(w, "Failed to retrieve profiles", err)
+		return
+	} else if found {
+		errors.WriteError(w, errors.NewConflictError(fmt.Sprintf("Profile %q already exists", profile.Slug)))
+		return
+	}
+
+	profile.UpdatedAt = time.Now().UTC()
+	if err := s.storage.SaveProfile(ctx, profile); err != nil {
+		writeStorageError(w, "Failed to save profile", err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, profile)
+}
+
+// UpdateProfileV1 handles replacing a profile's name, description and
+// servers. The slug cannot change.
+func (s *Server) UpdateProfileV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slug := r.PathValue("slug")
+
+	var profile models.Profile
+	if err := readJSON(w, r, &profile); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if profile.Slug != "" && profile.Slug != slug {
+		errors.WriteError(w, errors.NewBadRequestError("Profile slug cannot be changed"))
+		return
+	}
+	profile.Slug = slug
+
+	if _, ok := s.profileFromRequest(w, r); !ok {
+		return
+	}
+
+	if err := s.checkProfile(ctx, &profile); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	profile.UpdatedAt = time.Now().UTC()
+	if err := s.storage.SaveProfile(ctx, profile); err != nil {
+		writeStorageError(w, "Failed to save profile", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, profile)
+}
+
+// DeleteProfileV1 handles removing a profile
+func (s *Server) DeleteProfileV1(w http.ResponseWriter, r *http.Request) {
+	profile, ok := s.profileFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.storage.DeleteProfile(r.Context(), profile.Slug); err != nil {
+		writeStorageError(w, "Failed to delete profile", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkProfile validates a profile and resolves the servers it lists, given
+// by ID or slug, to their IDs. Only approved servers may be bundled.
+func (s *Server) checkProfile(ctx context.Context, profile *models.Profile) error {
+	if err := checkTerm(profile.Slug, profile.Name); err != nil {
+		return err
+	}
+	if len(profile.Servers) == 0 {
+		return errors.NewBadRequestError("A profile needs at least one server")
+	}
+
+	var ids, missing, unapproved []string
+	for _, ref := range profile.Servers {
+		server, _, err := s.lookupServer(ctx, ref)
+		if isNotFound(err) {
+			missing = append(missing, ref)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if server.Status != models.StatusApproved {
+			unapproved = append(unapproved, ref)
+		}
+		if !slices.Contains(ids, server.ID) {
+			ids = append(ids, server.ID)
+		}
+	}
+
+	if len(missing) > 0 {
+		return errors.NewValidationError("Profile references unknown servers", missing)
+	}
+	if len(unapproved) > 0 {
+		return errors.NewValidationError("Profile may only contain approved servers", unapproved)
+	}
+
+	profile.Servers = ids
+	return nil
+}
+
+// profileFromRequest resolves the {slug} wildcard of the request, writing a
+// not found error and returning false when no profile has that slug
+func (s *Server) profileFromRequest(w http.ResponseWriter, r *http.Request) (models.Profile, bool) {
+	profile, found, err := s.findProfile(r.Context(), r.PathValue("slug"))
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve profiles", err)
+		return models.Profile{}, false
+	} else if !found {
+		errors.WriteError(w, errors.NewNotFoundError("Profile"))
+		return models.Profile{}, false
+	}
+
+	return profile, true
+}
+
+func (s *Server) findProfile(ctx context.Context, slug string) (models.Profile, bool, error) {
+	profiles, err := s.storage.ListProfiles(ctx)
+	if err != nil {
+		return models.Profile{}, false, err
+	}
+
+	for _, profile := range profiles {
+		if profile.Slug == slug {
+			return profile, true, nil
+		}
+	}
+
+	return models.Profile{}, false, nil
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func TestDeleteProfile_OnlyRemovesProfiles(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	server, err := s.storage.CreateServer(ctx, models.Server{Name: "Alpha", Description: "first", Transport: "stdio", Status: "new"})
+	if err != nil {
+		t.Fatalf("creating server: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/api/profiles/v1/..%2F"+server.ID, nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for a slug naming no profile, got %d: %s", rec.Code, rec.Body)
+	}
+	if _, err := s.storage.GetServer(ctx, server.ID); err != nil {
+		t.Errorf("expected the server record to survive, got %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"encoding/base64"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/mcpregistry"
+	"github.com/bear-belly/mcp-registry/internal/middleware"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// Page sizes of the registry server list
+const (
+	defaultRegistryPageSize = 30
+	maxRegistryPageSize     = 100
+)
+
+// setupRegistryRoutes serves the read API of the community MCP Registry, so
+// that clients which can browse a registry see the approved catalog
+func (s *Server) setupRegistryRoutes() {
+	s.mux.Handle("OPTIONS /v0/", middleware.CorsMiddleware(http.NotFoundHandler()))
+
+	s.handleAPI("GET /v0/servers", s.ListRegistryServersV0)
+	s.handleAPI("GET /v0/servers/{id}", s.GetRegistryServerV0)
+	s.handleAPI("GET /v0/servers/{id}/versions", s.ListRegistryServerVersionsV0)
+}
+
+// ListRegistryServersV0 lists the latest version of every approved server,
+// ordered by name and paginated with an opaque cursor
+func (s *Server) ListRegistryServersV0(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := defaultRegistryPageSize
+	if value := query.Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > maxRegistryPageSize {
+			errors.WriteError(w, errors.NewBadRequestError("limit must be a number between 1 and "+strconv.Itoa(maxRegistryPageSize)))
+			return
+		}
+		limit = n
+	}
+
+	var after string
+	if cursor := query.Get("cursor"); cursor != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil || len(decoded) == 0 {
+			errors.WriteError(w, errors.NewBadRequestError("Invalid cursor"))
+			return
+		}
+		after = string(decoded)
+	}
+
+	var updatedSince time.Time
+	if value := query.Get("updated_since"); value != "" {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			errors.WriteError(w, errors.NewBadRequestError("updated_since must be an RFC 3339 timestamp"))
+			return
+		}
+		updatedSince = t
+	}
+
+	search := strings.ToLower(query.Get("search"))
+
+	servers, err := s.storage.ListServers(r.Context())
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve servers", err)
+		return
+	}
+	slices.SortFunc(servers, func(a, b models.Server) int { return strings.Compare(a.Slug, b.Slug) })
+
+	list := mcpregistry.ServerList{Servers: []mcpregistry.ServerJSON{}}
+	var last string
+	for _, server := range servers {
+		if server.Status != models.StatusApproved || server.Slug <= after {
+			continue
+		}
+		if !updatedSince.IsZero() && server.UpdatedAt.Before(updatedSince) {
+			continue
+		}
+		name := mcpregistry.Name(s.config.RegistryNamespace, server)
+		if search != "" && !strings.Contains(strings.ToLower(name), search) {
+			continue
+		}
+
+		// One past the page tells whether another page follows
+		if len(list.Servers) == limit {
+			list.Metadata.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(last))
+			break
+		}
+		list.Servers = append(list.Servers, mcpregistry.FromServer(server, s.config.RegistryNamespace, true))
+		last = server.Slug
+	}
+	list.Metadata.Count = len(list.Servers)
+
+	writeJSON(w, http.StatusOK, list)
+}
+
+// GetRegistryServerV0 returns an approved server by ID. The version query
+// parameter selects an earlier version from its history.
+func (s *Server) GetRegistryServerV0(w http.ResponseWriter, r *http.Request) {
+	server, ok := s.approvedServer(w, r)
+	if !ok {
+		return
+	}
+
+	version := r.URL.Query().Get("version")
+	if version == "" || version == "latest" || version == server.Version {
+		writeJSON(w, http.StatusOK, mcpregistry.FromServer(server, s.config.RegistryNamespace, true))
+		return
+	}
+
+	versions, err := s.registryVersions(r, server)
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve server versions", err)
+		return
+	}
+	for _, snapshot := range versions {
+		if snapshot.Version == version {
+			writeJSON(w, http.StatusOK, mcpregistry.FromServer(snapshot, s.config.RegistryNamespace, false))
+			return
+		}
+	}
+
+	errors.WriteError(w, errors.NewNotFoundError("Server version"))
+}
+
+// ListRegistryServerVersionsV0 lists the approved versions of a server,
+// newest first
+func (s *Server) ListRegistryServerVersionsV0(w http.ResponseWriter, r *http.Request) {
+	server, ok := s.approvedServer(w, r)
+	if !ok {
+		return
+	}
+
+	versions, err := s.registryVersions(r, server)
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve server versions", err)
+		return
+	}
+
+	list := mcpregistry.ServerList{Servers: []mcpregistry.ServerJSON{}}
+	for _, snapshot := range versions {
+		isLatest := snapshot.Version == server.Version
+		list.Servers = append(list.Servers, mcpregistry.FromServer(snapshot, s.config.RegistryNamespace, isLatest))
+	}
+	list.Metadata.Count = len(list.Servers)
+
+	writeJSON(w, http.StatusOK, list)
+}
+
+// approvedServer resolves the {id} wildcard. Servers that are not approved
+// are reported as not found, so the registry does not reveal them.
+func (s *Server) approvedServer(w http.ResponseWriter, r *http.Request) (models.Server, bool) {
+	server, err := s.storage.GetServer(r.Context(), r.PathValue("id"))
+	if err == nil && server.Status != models.StatusApproved {
+		err = errors.NewNotFoundError("Server")
+	}
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve server", err)
+		return models.Server{}, false
+	}
+	return server, true
+}
+
+// registryVersions returns the versions of a server that were approved when
+// they were recorded, newest first. The current record is listed first when
+// it has no snapshot of its own, as for a server without a version.
+func (s *Server) registryVersions(r *http.Request, server models.Server) ([]models.Server, error) {
+	history, err := s.storage.ListServerVersions(r.Context(), server.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []models.Server{}
+	if !slices.ContainsFunc(history, func(snapshot models.Server) bool { return snapshot.Version == server.Version }) {
+		versions = append(versions, server)
+	}
+	for _, snapshot := range history {
+		if snapshot.Status == models.StatusApproved {
+			versions = append(versions, snapshot)
+		}
+	}
+
+	return versions, nil
+}
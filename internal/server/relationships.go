@@ -0,0 +1,184 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// relationshipGraph is the response of the relationships endpoint. Each
+// link appears once however many of its ends declare it.
+type relationshipGraph struct {
+	Nodes []relationshipNode `json:"nodes"`
+	Edges []relationshipEdge `json:"edges"`
+}
+
+type relationshipNode struct {
+	ID     string `json:"id"`
+	Slug   string `json:"slug"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// relationshipEdge is a link in canonical form: replaced-by is reported as
+// replaces from the other end
+type relationshipEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Type   string `json:"type"`
+	Note   string `json:"note,omitempty"`
+}
+
+// relatedLink is one entry in the related servers list of a server page,
+// worded from that server's point of view
+type relatedLink struct {
+	Label  string
+	Type   string
+	Server models.Server
+	Note   string
+}
+
+// serverPage is the data behind a server details page
+type serverPage struct {
+	models.Server
+	Related       []relatedLink
+	ReplacedBy    []models.Server
+	ClientConfigs []clientConfigView
+}
+
+// ListRelationshipsV1 returns the relationship graph of the catalog. With
+// ?server=<id or slug> only the links of that server and its neighbours are
+// returned.
+func (s *Server) ListRelationshipsV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	servers, err := s.storage.ListServers(ctx)
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve servers", err)
+		return
+	}
+
+	edges := relationshipEdges(servers)
+
+	if ref := r.URL.Query().Get("server"); ref != "" {
+		server, _, err := s.lookupServer(ctx, ref)
+		if err != nil {
+			writeStorageError(w, "Failed to retrieve server", err)
+			return
+		}
+
+		var neighbourhood []relationshipEdge
+		for _, edge := range edges {
+			if edge.Source == server.ID || edge.Target == server.ID {
+				neighbourhood = append(neighbourhood, edge)
+			}
+		}
+		edges = neighbourhood
+	}
+
+	graph := relationshipGraph{Nodes: []relationshipNode{}, Edges: []relationshipEdge{}}
+	linked := map[string]bool{}
+	for _, edge := range edges {
+		linked[edge.Source] = true
+		linked[edge.Target] = true
+		graph.Edges = append(graph.Edges, edge)
+	}
+	for _, server := range servers {
+		if linked[server.ID] {
+			graph.Nodes = append(graph.Nodes, relationshipNode{
+				ID:     server.ID,
+				Slug:   server.Slug,
+				Name:   server.Name,
+				Status: server.Status,
+			})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, graph)
+}
+
+// relationshipEdges collects the declared relationships of every server,
+// dropping duplicates and links to servers that no longer exist
+func relationshipEdges(servers []models.Server) []relationshipEdge {
+	exists := map[string]bool{}
+	for _, server := range servers {
+		exists[server.ID] = true
+	}
+
+	var edges []relationshipEdge
+	seen := map[relationshipEdge]bool{}
+	for _, server := range servers {
+		for _, relationship := range server.Relationships {
+			if !exists[relationship.Target] {
+				continue
+			}
+
+			from, to, relationType := models.CanonicalRelationship(server.ID, relationship)
+			key := relationshipEdge{Source: from, Target: to, Type: relationType}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			key.Note = relationship.Note
+			edges = append(edges, key)
+		}
+	}
+
+	return edges
+}
+
+// buildServerPage gathers the servers linked to the given one, whichever end
+// declared the relationship
+func buildServerPage(server models.Server, servers []models.Server) serverPage {
+	page := serverPage{Server: server}
+
+	byID := map[string]models.Server{}
+	for _, other := range servers {
+		byID[other.ID] = other
+	}
+
+	for _, edge := range relationshipEdges(servers) {
+		outgoing := edge.Source == server.ID
+		if !outgoing && edge.Target != server.ID {
+			continue
+		}
+
+		other := byID[edge.Target]
+		if !outgoing {
+			other = byID[edge.Source]
+		}
+
+		page.Related = append(page.Related, relatedLink{
+			Label:  relationshipLabel(edge.Type, outgoing),
+			Type:   edge.Type,
+			Server: other,
+			Note:   edge.Note,
+		})
+
+		if edge.Type == models.RelationReplaces && !outgoing {
+			page.ReplacedBy = append(page.ReplacedBy, other)
+		}
+	}
+
+	return page
+}
+
+func relationshipLabel(relationType string, outgoing bool) string {
+	switch relationType {
+	case models.RelationReplaces:
+		if outgoing {
+			return "Replaces"
+		}
+		return "Replaced by"
+	case models.RelationDependsOn:
+		if outgoing {
+			return "Depends on"
+		}
+		return "Required by"
+	case models.RelationAlternativeTo:
+		return "Alternative to"
+	case models.RelationBundledWith:
+		return "Bundled with"
+	}
+	return relationType
+}
@@ -0,0 +1,328 @@
+package server
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpcreflect"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/events"
+	registryv1 "github.com/bear-belly/mcp-registry/internal/gen/registry/v1"
+	"github.com/bear-belly/mcp-registry/internal/gen/registry/v1/registryv1connect"
+	"github.com/bear-belly/mcp-registry/internal/logger"
+	"github.com/bear-belly/mcp-registry/internal/middleware"
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/protoconv"
+)
+
+// adminProcedures are the RPCs that require the admin token
+var adminProcedures = []string{
+	registryv1connect.ServerServiceCreateServerProcedure,
+	registryv1connect.ServerServiceUpdateServerProcedure,
+	registryv1connect.ServerServiceChangeServerStatusProcedure,
+	registryv1connect.ServerServiceDeleteServerProcedure,
+}
+
+// setupRPCRoutes serves the protobuf services over gRPC, gRPC-Web and
+// Connect. They share the port of the HTTP API but not its middleware:
+// RPCs negotiate compression themselves and are never cached. Reflection
+// lets tools such as grpcurl discover the services.
+func (s *Server) setupRPCRoutes() {
+	s.rpc = http.NewServeMux()
+	s.rpc.Handle(registryv1connect.NewServerServiceHandler(&serverService{s: s},
+		connect.WithInterceptors(s.adminInterceptor())))
+
+	reflector := grpcreflect.NewStaticReflector(registryv1connect.ServerServiceName)
+	s.rpc.Handle(grpcreflect.NewHandlerV1(reflector))
+	s.rpc.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
+}
+
+// adminInterceptor checks the admin token of the mutating RPCs, as
+// AdminMiddleware does for the REST API
+func (s *Server) adminInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if slices.Contains(adminProcedures, req.Spec().Procedure) {
+				if err := middleware.CheckAdminToken(s.config.AdminToken, req.Header()); err != nil {
+					return nil, rpcError("Not allowed", err)
+				}
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// rpcError maps an application error onto the closest RPC status code.
+// Other failures are logged and reported without their cause.
+func rpcError(message string, err error) error {
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		logger.Error(message, "error", err)
+		return connect.NewError(connect.CodeInternal, stderrors.New(message))
+	}
+
+	code := connect.CodeInternal
+	switch appErr.Type {
+	case errors.ErrorTypeValidation, errors.ErrorTypeBadRequest:
+		code = connect.CodeInvalidArgument
+	case errors.ErrorTypeNotFound:
+		code = connect.CodeNotFound
+	case errors.ErrorTypeConflict:
+		code = connect.CodeFailedPrecondition
+	case errors.ErrorTypeAuthentication:
+		code = connect.CodeUnauthenticated
+	case errors.ErrorTypeAuthorization:
+		code = connect.CodePermissionDenied
+	default:
+		logger.Error(message, "error", err)
+		return connect.NewError(code, stderrors.New(message))
+	}
+	return connect.NewError(code, stderrors.New(appErr.Message))
+}
+
+// serverService implements registry.v1.ServerService over the same storage
+// and rules as the REST API
+type serverService struct {
+	s *Server
+}
+
+func (svc *serverService) ListServers(ctx context.Context, req *connect.Request[registryv1.ListServersRequest]) (*connect.Response[registryv1.ListServersResponse], error) {
+	msg := req.Msg
+	values := url.Values{
+		"status":      msg.GetStatus(),
+		"transport":   msg.GetTransport(),
+		"q":           msg.GetQ(),
+		"owner":       msg.GetOwner(),
+		"vendor":      msg.GetVendor(),
+		"vendorType":  msg.GetVendorType(),
+		"supportTier": msg.GetSupportTier(),
+		"tag":         msg.GetTag(),
+		"category":    msg.GetCategory(),
+		"risk":        msg.GetRisk(),
+	}
+	if msg.PageSize != 0 {
+		values.Set("limit", strconv.Itoa(int(msg.PageSize)))
+	}
+	if msg.PageToken != "" {
+		values.Set("cursor", msg.PageToken)
+	}
+	if msg.Sort != "" {
+		values.Set("sort", msg.Sort)
+	}
+	if msg.MinRisk != nil {
+		values.Set("minRisk", strconv.Itoa(int(msg.GetMinRisk())))
+	}
+	if msg.MaxRisk != nil {
+		values.Set("maxRisk", strconv.Itoa(int(msg.GetMaxRisk())))
+	}
+	if msg.CreatedAfter != nil {
+		values.Set("createdAfter", msg.CreatedAfter.AsTime().Format(time.RFC3339Nano))
+	}
+	if msg.UpdatedSince != nil {
+		values.Set("updatedSince", msg.UpdatedSince.AsTime().Format(time.RFC3339Nano))
+	}
+	for name, value := range values {
+		if len(value) == 0 {
+			delete(values, name)
+		}
+	}
+
+	query, err := parseServerListQuery(values, serverCodecV1)
+	if err != nil {
+		return nil, rpcError("Invalid list request", err)
+	}
+
+	servers, err := svc.s.storage.ListServers(ctx)
+	if err != nil {
+		return nil, rpcError("Failed to retrieve servers", err)
+	}
+	svc.s.assessServers(servers)
+
+	list, err := query.page(filterServers(servers, values))
+	if err != nil {
+		return nil, rpcError("Failed to build the server list", err)
+	}
+
+	response := &registryv1.ListServersResponse{NextPageToken: list.NextCursor, TotalSize: int32(list.Total)}
+	for _, item := range list.Items {
+		server, err := protoconv.FromServer(item.(models.Server))
+		if err != nil {
+			return nil, rpcError("Failed to encode server", err)
+		}
+		response.Servers = append(response.Servers, server)
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+func (svc *serverService) GetServer(ctx context.Context, req *connect.Request[registryv1.GetServerRequest]) (*connect.Response[registryv1.GetServerResponse], error) {
+	server, _, err := svc.s.lookupServer(ctx, req.Msg.GetRef())
+	if err != nil {
+		return nil, rpcError("Failed to retrieve server", err)
+	}
+
+	message, err := protoconv.FromServer(svc.s.assess(server))
+	if err != nil {
+		return nil, rpcError("Failed to encode server", err)
+	}
+	return connect.NewResponse(&registryv1.GetServerResponse{Server: message}), nil
+}
+
+// WatchServers streams events until the client goes away. A client that
+// falls too far behind gets Unavailable, and resumes with the ID of the last
+// event it received.
+func (svc *serverService) WatchServers(ctx context.Context, req *connect.Request[registryv1.WatchServersRequest], stream *connect.ServerStream[registryv1.WatchServersResponse]) error {
+	types := req.Msg.GetTypes()
+	for _, eventType := range types {
+		if !slices.Contains(events.Types, eventType) {
+			return connect.NewError(connect.CodeInvalidArgument, stderrors.New("types must be a list of "+strings.Join(events.Types, ", ")))
+		}
+	}
+
+	replay, feed, cancel, complete := svc.s.events.Subscribe(req.Msg.GetLastEventId())
+	defer cancel()
+
+	send := func(event events.Event) error {
+		if len(types) > 0 && !slices.Contains(types, event.Type) {
+			return nil
+		}
+		message, err := protoconv.FromEvent(event)
+		if err != nil {
+			return rpcError("Failed to encode event", err)
+		}
+		return stream.Send(&registryv1.WatchServersResponse{Event: message})
+	}
+
+	if !complete {
+		reset := &registryv1.ServerEvent{Type: eventStreamReset}
+		if err := stream.Send(&registryv1.WatchServersResponse{Event: reset}); err != nil {
+			return err
+		}
+	}
+	for _, event := range replay {
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-feed:
+			if !ok {
+				return connect.NewError(connect.CodeUnavailable, stderrors.New("Fell too far behind the event stream; resume from the last event received"))
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (svc *serverService) CreateServer(ctx context.Context, req *connect.Request[registryv1.CreateServerRequest]) (*connect.Response[registryv1.CreateServerResponse], error) {
+	server := protoconv.ToServer(req.Msg.GetServer())
+
+	// Attachments can only be added by uploading them
+	server.Attachments = nil
+
+	created, err := svc.s.storage.CreateServer(ctx, server)
+	if err != nil {
+		return nil, rpcError("Failed to create server", err)
+	}
+
+	message, err := protoconv.FromServer(svc.s.assess(created))
+	if err != nil {
+		return nil, rpcError("Failed to encode server", err)
+	}
+	return connect.NewResponse(&registryv1.CreateServerResponse{Server: message}), nil
+}
+
+// UpdateServer replaces a server, keeping its ID, creation time and
+// attachments as ReplaceServer does
+func (svc *serverService) UpdateServer(ctx context.Context, req *connect.Request[registryv1.UpdateServerRequest]) (*connect.Response[registryv1.UpdateServerResponse], error) {
+	found, _, err := svc.s.lookupServer(ctx, req.Msg.GetRef())
+	if err != nil {
+		return nil, rpcError("Failed to retrieve server", err)
+	}
+	existing, unlock, err := svc.s.lockedServer(ctx, found.ID)
+	if err != nil {
+		return nil, rpcError("Failed to retrieve server", err)
+	}
+	defer unlock()
+
+	server := protoconv.ToServer(req.Msg.GetServer())
+	if server.ID != "" && server.ID != existing.ID {
+		return nil, rpcError("Invalid update", errors.NewBadRequestError("The server ID cannot be changed"))
+	}
+	if err := checkTransition(existing.Status, server.Status); err != nil {
+		return nil, rpcError("Invalid update", err)
+	}
+
+	server.ID = existing.ID
+	server.Attachments = existing.Attachments
+
+	updated, err := svc.s.storage.UpdateServer(ctx, server)
+	if err != nil {
+		return nil, rpcError("Failed to update server", err)
+	}
+
+	message, err := protoconv.FromServer(svc.s.assess(updated))
+	if err != nil {
+		return nil, rpcError("Failed to encode server", err)
+	}
+	return connect.NewResponse(&registryv1.UpdateServerResponse{Server: message}), nil
+}
+
+func (svc *serverService) ChangeServerStatus(ctx context.Context, req *connect.Request[registryv1.ChangeServerStatusRequest]) (*connect.Response[registryv1.ChangeServerStatusResponse], error) {
+	found, _, err := svc.s.lookupServer(ctx, req.Msg.GetRef())
+	if err != nil {
+		return nil, rpcError("Failed to retrieve server", err)
+	}
+	server, unlock, err := svc.s.lockedServer(ctx, found.ID)
+	if err != nil {
+		return nil, rpcError("Failed to retrieve server", err)
+	}
+	defer unlock()
+
+	if err := checkTransition(server.Status, req.Msg.GetStatus()); err != nil {
+		return nil, rpcError("Invalid status change", err)
+	}
+
+	server.Status = req.Msg.GetStatus()
+	updated, err := svc.s.storage.UpdateServer(ctx, server)
+	if err != nil {
+		return nil, rpcError("Failed to change server status", err)
+	}
+
+	message, err := protoconv.FromServer(svc.s.assess(updated))
+	if err != nil {
+		return nil, rpcError("Failed to encode server", err)
+	}
+	return connect.NewResponse(&registryv1.ChangeServerStatusResponse{Server: message}), nil
+}
+
+func (svc *serverService) DeleteServer(ctx context.Context, req *connect.Request[registryv1.DeleteServerRequest]) (*connect.Response[registryv1.DeleteServerResponse], error) {
+	server, _, err := svc.s.lookupServer(ctx, req.Msg.GetRef())
+	if err != nil {
+		return nil, rpcError("Failed to retrieve server", err)
+	}
+
+	if err := svc.s.storage.DeleteServer(ctx, server.ID); err != nil {
+		return nil, rpcError("Failed to delete server", err)
+	}
+
+	for _, attachment := range server.Attachments {
+		svc.s.releaseBlob(ctx, attachment.SHA256)
+	}
+
+	return connect.NewResponse(&registryv1.DeleteServerResponse{}), nil
+}
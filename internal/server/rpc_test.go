@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/bear-belly/mcp-registry/internal/events"
+	registryv1 "github.com/bear-belly/mcp-registry/internal/gen/registry/v1"
+	"github.com/bear-belly/mcp-registry/internal/gen/registry/v1/registryv1connect"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// newRPCClient serves s over cleartext HTTP/2, as the server does, and
+// returns a gRPC client for it
+func newRPCClient(t *testing.T, s *Server) registryv1connect.ServerServiceClient {
+	t.Helper()
+
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+
+	ts := httptest.NewUnstartedServer(s.Handler())
+	ts.Config.Protocols = protocols
+	ts.Start()
+	t.Cleanup(ts.Close)
+
+	client := &http.Client{Transport: &http.Transport{Protocols: protocols}}
+	return registryv1connect.NewServerServiceClient(client, ts.URL, connect.WithGRPC())
+}
+
+func withToken[T any](message *T, token string) *connect.Request[T] {
+	req := connect.NewRequest(message)
+	req.Header().Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func TestRPC_ManagesServersOverGRPC(t *testing.T) {
+	s := newTestServer(t)
+	s.config.AdminToken = "secret"
+	client := newRPCClient(t, s)
+	ctx := context.Background()
+
+	server := &registryv1.Server{Name: "Alpha", Description: "first", Transport: models.TransportStdio, Status: models.StatusNew}
+	_, err := client.CreateServer(ctx, connect.NewRequest(&registryv1.CreateServerRequest{Server: server}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected creating without the token to be unauthenticated, got %v", err)
+	}
+
+	created, err := client.CreateServer(ctx, withToken(&registryv1.CreateServerRequest{Server: server}, "secret"))
+	if err != nil {
+		t.Fatalf("creating server: %v", err)
+	}
+	if created.Msg.Server.Slug != "alpha" || created.Msg.Server.RiskAssessment == nil {
+		t.Errorf("expected the stored, assessed server back, got %v", created.Msg.Server)
+	}
+
+	_, err = client.ChangeServerStatus(ctx, withToken(&registryv1.ChangeServerStatusRequest{Ref: "alpha", Status: models.StatusApproved}, "secret"))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("expected the workflow to forbid new to approved, got %v", err)
+	}
+
+	list, err := client.ListServers(ctx, connect.NewRequest(&registryv1.ListServersRequest{Status: []string{models.StatusNew}, PageSize: 10}))
+	if err != nil {
+		t.Fatalf("listing servers: %v", err)
+	}
+	if list.Msg.TotalSize != 1 || len(list.Msg.Servers) != 1 || list.Msg.Servers[0].Id != created.Msg.Server.Id {
+		t.Errorf("expected the new server to be listed, got %v", list.Msg)
+	}
+
+	_, err = client.GetServer(ctx, connect.NewRequest(&registryv1.GetServerRequest{Ref: "missing"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
+
+func TestRPC_WatchResumesAfterLastEvent(t *testing.T) {
+	s := newTestServer(t)
+	client := newRPCClient(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, feed, unsubscribe, _ := s.events.Subscribe("")
+	defer unsubscribe()
+	for _, name := range []string{"Alpha", "Bravo"} {
+		if _, err := s.storage.CreateServer(ctx, models.Server{Name: name, Description: name, Transport: models.TransportStdio, Status: models.StatusNew}); err != nil {
+			t.Fatalf("creating %s: %v", name, err)
+		}
+	}
+	first := <-feed
+
+	stream, err := client.WatchServers(ctx, connect.NewRequest(&registryv1.WatchServersRequest{
+		Types: []string{events.ServerCreated}, LastEventId: first.ID,
+	}))
+	if err != nil {
+		t.Fatalf("watching servers: %v", err)
+	}
+	defer stream.Close()
+
+	if !stream.Receive() {
+		t.Fatalf("expected a replayed event, got %v", stream.Err())
+	}
+	event := stream.Msg().Event
+	if event.Type != events.ServerCreated || event.Server.GetName() != "Bravo" {
+		t.Errorf("expected the creation of Bravo to be replayed, got %v", event)
+	}
+}
@@ -1,41 +1,85 @@
 package server
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/graphql-go/graphql"
+
+	"github.com/bear-belly/mcp-registry/internal/blob"
 	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/events"
+	"github.com/bear-belly/mcp-registry/internal/license"
 	"github.com/bear-belly/mcp-registry/internal/middleware"
 	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/risk"
 	"github.com/bear-belly/mcp-registry/internal/storage"
 	"github.com/bear-belly/mcp-registry/internal/templates"
+	"github.com/bear-belly/mcp-registry/internal/webhooks"
 )
 
 type Server struct {
 	config        models.Config
 	storage       storage.Storage
+	blobs         blob.Store
+	events        *events.Broker
+	webhooks      *webhooks.Dispatcher
 	mux           *http.ServeMux
+	rpc           *http.ServeMux
 	startTime     time.Time
 	healthyStatus *bool
+
+	// catalog is the storage wrapper that records when the catalog last
+	// changed, for Last-Modified
+	catalog *storage.PublishingStorage
+
+	graphQLSchema graphql.Schema
+
+	// versionUsage counts the requests served by each API version
+	versionUsage map[string]*atomic.Int64
+
+	// serverLocks serialise the read-modify-write of changes to a server,
+	// striped by server ID
+	serverLocks [64]sync.Mutex
+
+	// idempotentRequests holds the record IDs of the requests with an
+	// Idempotency-Key that are in progress
+	idempotentRequests sync.Map
+
+	// apiRoutes lists the API routes in registration order, for the OpenAPI
+	// document
+	apiRoutes []apiRoute
 }
 
 type Metrics struct {
 	Uptime float64 `json:"uptime_seconds"`
 }
 
-func New(storage storage.Storage, config models.Config) *Server {
+// eventReplaySize is how many catalog events are kept for clients resuming
+// the change feed
+const eventReplaySize = 1000
+
+func New(store storage.Storage, blobs blob.Store, config models.Config) *Server {
 	healthyStatus := true
+	broker := events.NewBroker(eventReplaySize)
+	catalog := storage.NewPublishingStorage(store, broker)
 
 	return &Server{
 		config:        config,
-		storage:       storage,
+		storage:       catalog,
+		catalog:       catalog,
+		blobs:         blobs,
+		events:        broker,
+		webhooks:      webhooks.NewDispatcher(catalog, &http.Client{}, webhooks.DefaultPolicy),
 		mux:           http.NewServeMux(),
 		startTime:     time.Now(),
+		versionUsage:  newVersionUsage(),
 		healthyStatus: &healthyStatus,
 	}
 }
@@ -64,22 +108,6 @@ func (s *Server) setupHealthRoutes() {
 	})
 }
 
-func (s *Server) getServerByName(name string) (models.Server, error) {
-	ctx := context.Background()
-	servers, err := s.storage.ListServers(ctx)
-	if err != nil {
-		return models.Server{}, err
-	}
-
-	for _, server := range servers {
-		if server.Name == name {
-			return server, nil
-		}
-	}
-
-	return models.Server{}, errors.NewNotFoundError("Server")
-}
-
 func (s *Server) setupHomeRoute() {
 	// Home page route
 	s.mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -91,8 +119,8 @@ func (s *Server) setupHomeRoute() {
 
 		ctx := r.Context()
 
-		// Retrieve servers from storage
-		servers, err := s.storage.ListServers(ctx)
+		// Retrieve servers and facets from storage
+		page, err := s.buildIndexPage(ctx, r.URL.Query())
 		if err != nil {
 			errors.WriteError(w, errors.NewInternalError("Error retrieving servers", err))
 			return
@@ -102,7 +130,7 @@ func (s *Server) setupHomeRoute() {
 		data := templates.PageData{
 			Title:        "MCP Registry",
 			PageTemplate: "index",
-			Data:         servers,
+			Data:         page,
 		}
 
 		// Render the template
@@ -111,22 +139,18 @@ func (s *Server) setupHomeRoute() {
 		}
 	}))
 
-	// Server details route
-	s.mux.Handle("/server/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		serverName := strings.TrimPrefix(r.URL.Path, "/server/")
-		if serverName == "" {
-			http.NotFound(w, r)
+	// Server details route, addressed by slug. Old slugs redirect permanently.
+	s.mux.Handle("GET /server/{server}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		server, ok := s.serverFromRequest(w, r, http.StatusMovedPermanently)
+		if !ok {
 			return
 		}
+		server = s.assess(server)
 
-		ctx := r.Context()
-		server, err := s.getServerByName(serverName)
+		servers, err := s.storage.ListServers(ctx)
 		if err != nil {
-			if _, ok := err.(*errors.AppError); ok {
-				errors.WriteError(w, err)
-			} else {
-				errors.WriteError(w, errors.NewInternalError("Error retrieving server", err))
-			}
+			errors.WriteError(w, errors.NewInternalError("Error retrieving related servers", err))
 			return
 		}
 
@@ -141,11 +165,17 @@ func (s *Server) setupHomeRoute() {
 			configJSON = string(configBytes)
 		}
 
+		page := buildServerPage(server, servers)
+		if page.ClientConfigs, err = clientConfigViews(server); err != nil {
+			errors.WriteError(w, errors.NewInternalError("Error generating client configs", err))
+			return
+		}
+
 		// Map server data to template data
 		data := templates.PageData{
 			Title:        server.Name + " - MCP Registry",
 			PageTemplate: "server",
-			Data:         server,
+			Data:         page,
 			ConfigJSON:   configJSON,
 		}
 
@@ -175,33 +205,112 @@ func (s *Server) recoveryMiddleware(next http.Handler) http.Handler {
 }
 
 func (s *Server) setupApiRoutes() {
-	s.mux.Handle("/api/servers/v1", middleware.CorsMiddleware(
-		http.HandlerFunc(s.ListServersV1)))
+	// Answer CORS preflight requests for every API route
+	s.mux.Handle("OPTIONS /api/", middleware.CorsMiddleware(http.NotFoundHandler()))
 
-	// TODO: add further CRUD operation endpoints
+	s.handleServerAPI("GET ", s.ListServers)
+	s.handleServerAdminAPI("POST ", s.CreateServer)
+	s.handleServerAPI("GET /{server}", s.GetServer)
+	s.handleServerAdminAPI("PUT /{server}", s.ReplaceServer)
+	s.handleServerAdminAPI("PATCH /{server}", s.PatchServer)
+	s.handleServerAdminAPI("DELETE /{server}", s.DeleteServer)
+	s.handleServerAdminAPI("POST /{server}/status", s.ChangeServerStatus)
+	s.handleServerAPI("GET /{server}/tools", s.ListServerTools)
+	s.handleAPI("GET /api/relationships/v1", s.ListRelationshipsV1)
+	s.handleAPI("GET /api/versions", s.ListAPIVersions)
+	s.handleAPI("GET /api/openapi.json", s.ServeOpenAPI)
 }
 
-// ListJourneys handles retrieving a list of journeys
-func (s *Server) ListServersV1(w http.ResponseWriter, r *http.Request) {
+// handleAPI registers an API route behind the CORS middleware
+func (s *Server) handleAPI(pattern string, handler http.HandlerFunc) {
+	s.mux.Handle(pattern, middleware.CorsMiddleware(handler))
+	s.recordAPIRoute(pattern, false)
+}
+
+// handleAdminAPI registers an API route that requires the admin token
+func (s *Server) handleAdminAPI(pattern string, handler http.HandlerFunc) {
+	s.mux.Handle(pattern, middleware.CorsMiddleware(
+		middleware.AdminMiddleware(s.config.AdminToken, handler)))
+	s.recordAPIRoute(pattern, true)
+}
+
+func (s *Server) recordAPIRoute(pattern string, admin bool) {
+	method, path, _ := strings.Cut(pattern, " ")
+	s.apiRoutes = append(s.apiRoutes, apiRoute{Method: method, Path: path, Admin: admin})
+}
+
+// ListServers lists the servers matching the query filters, one page at a
+// time
+func (s *Server) ListServers(w http.ResponseWriter, r *http.Request) {
+	query, err := parseServerListQuery(r.URL.Query(), apiVersionFrom(r.Context()).codec)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
 	servers, err := s.storage.ListServers(r.Context())
 	if err != nil {
-		errors.WriteError(w, errors.NewDatabaseError("Failed to retrieve journeys", err))
+		writeStorageError(w, "Failed to retrieve servers", err)
+		return
+	}
+
+	s.assessServers(servers)
+	list, err := query.page(filterServers(servers, r.URL.Query()))
+	if err != nil {
+		errors.WriteError(w, errors.NewInternalError("Failed to build the server list", err))
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(servers)
+	writeJSON(w, http.StatusOK, list)
 }
 
 func (s *Server) SetupRoutes() {
 	s.setupStaticRoutes()
 	s.setupHealthRoutes()
 	s.setupApiRoutes()
+	s.setupTaxonomyRoutes()
+	s.setupAttachmentRoutes()
+	s.setupRegistryRoutes()
+	s.setupBulkRoutes()
+	s.setupClientConfigRoutes()
+	s.setupProfileRoutes()
+	s.setupEventRoutes()
+	s.setupWebhookRoutes()
+	s.setupGraphQLRoutes()
+	s.setupRPCRoutes()
+	s.setupDocsRoutes()
 	s.setupHomeRoute()
 }
 
 func (s *Server) Handler() http.Handler {
-	return s.recoveryMiddleware(s.timingMiddleware(s.mux))
+	web := s.cachingMiddleware(middleware.CompressMiddleware(s.idempotencyMiddleware(s.mux)))
+	return s.recoveryMiddleware(s.timingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if handler, pattern := s.rpc.Handler(r); pattern != "" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		web.ServeHTTP(w, r)
+	})))
+}
+
+// assessServers computes the read-time assessments of each server in place
+func (s *Server) assessServers(servers []models.Server) {
+	for i := range servers {
+		servers[i] = s.assess(servers[i])
+	}
+}
+
+// assess returns the server with its risk assessment and license policy
+// decision computed
+func (s *Server) assess(server models.Server) models.Server {
+	assessment := risk.Assess(server.Risk, s.config.RiskWeights)
+	server.RiskAssessment = &assessment
+
+	if expression, err := license.Parse(server.License); err == nil {
+		server.LicenseDecision = license.Evaluate(expression, s.config.LicensePolicy)
+	}
+
+	return server
 }
 
 func (s *Server) SetHealthStatus(healthy bool) {
@@ -0,0 +1,348 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/patch"
+)
+
+// acceptedPatchTypes lists the patch formats of the PATCH endpoint, as
+// advertised in the Accept-Patch header
+var acceptedPatchTypes = []string{patch.MergePatchType, patch.JSONPatchType}
+
+// statusChange is the body of the status endpoint
+type statusChange struct {
+	Status string `json:"status"`
+}
+
+// GetServer returns a single server, addressed by ID or slug
+func (s *Server) GetServer(w http.ResponseWriter, r *http.Request) {
+	server, ok := s.serverFromRequest(w, r, http.StatusPermanentRedirect)
+	if !ok {
+		return
+	}
+
+	s.writeServer(w, r, http.StatusOK, server)
+}
+
+// writeServer writes the assessed server in the representation of the API
+// version of the request, with the ETag that If-Match is checked against
+func (s *Server) writeServer(w http.ResponseWriter, r *http.Request, status int, server models.Server) {
+	body, err := s.encodeServer(r, server)
+	if err != nil {
+		errors.WriteError(w, errors.NewInternalError("Failed to encode server", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", bodyETag(body))
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// encodeServer returns the response body of the assessed server in the
+// representation of the API version of the request
+func (s *Server) encodeServer(r *http.Request, server models.Server) ([]byte, error) {
+	body, err := json.Marshal(apiVersionFrom(r.Context()).codec.toJSON(s.assess(server)))
+	if err != nil {
+		return nil, err
+	}
+	return append(body, '\n'), nil
+}
+
+// CreateServer adds a server to the catalog. The registry assigns the ID
+// unless one is supplied, and derives the slug from the name.
+func (s *Server) CreateServer(w http.ResponseWriter, r *http.Request) {
+	version := apiVersionFrom(r.Context())
+	server, err := version.codec.read(w, r)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Attachments can only be added by uploading them
+	server.Attachments = nil
+
+	created, err := s.storage.CreateServer(r.Context(), server)
+	if err != nil {
+		writeStorageError(w, "Failed to create server", err)
+		return
+	}
+
+	w.Header().Set("Location", version.serverPath(created))
+	s.writeServer(w, r, http.StatusCreated, created)
+}
+
+// ReplaceServer replaces a server record. The ID, creation time and
+// attachments are kept from the stored record; renaming the server moves it
+// to a new slug and keeps the old one as a redirect.
+func (s *Server) ReplaceServer(w http.ResponseWriter, r *http.Request) {
+	existing, unlock, ok := s.lockServerFromRequest(w, r)
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	server, err := apiVersionFrom(r.Context()).codec.read(w, r)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+	if server.ID != "" && server.ID != existing.ID {
+		errors.WriteError(w, errors.NewBadRequestError("The server ID cannot be changed"))
+		return
+	}
+	if err := checkTransition(existing.Status, server.Status); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	server.ID = existing.ID
+	server.Attachments = existing.Attachments
+
+	updated, err := s.storage.UpdateServer(r.Context(), server)
+	if err != nil {
+		writeStorageError(w, "Failed to update server", err)
+		return
+	}
+
+	s.writeServer(w, r, http.StatusOK, updated)
+}
+
+// PatchServer applies a JSON Merge Patch or JSON Patch to a server. The
+// patch is applied to the record, as the API version represents it, as a
+// whole and the result is validated like a replacement, so a failing
+// operation changes nothing. Patches to a server are applied one after
+// another, and If-Match makes one conditional on the state it was written
+// against.
+func (s *Server) PatchServer(w http.ResponseWriter, r *http.Request) {
+	existing, unlock, ok := s.lockServerFromRequest(w, r)
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != patch.MergePatchType && mediaType != patch.JSONPatchType {
+		w.Header().Set("Accept-Patch", strings.Join(acceptedPatchTypes, ", "))
+		errors.WriteError(w, errors.NewBadRequestError("Content-Type must be one of "+strings.Join(acceptedPatchTypes, ", ")).
+			SetStatusCode(http.StatusUnsupportedMediaType))
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes))
+	if err != nil {
+		errors.WriteError(w, errors.NewBadRequestError(fmt.Sprintf("Request body exceeds %d bytes", maxRequestBodyBytes)).
+			SetStatusCode(http.StatusRequestEntityTooLarge))
+		return
+	}
+
+	codec := apiVersionFrom(r.Context()).codec
+	document, err := json.Marshal(codec.toJSON(existing))
+	if err != nil {
+		errors.WriteError(w, errors.NewInternalError("Failed to encode server", err))
+		return
+	}
+
+	var patched []byte
+	if mediaType == patch.MergePatchType {
+		patched, err = patch.MergePatch(document, body)
+	} else {
+		var operations patch.JSONPatch
+		if operations, err = patch.ParseJSONPatch(body); err == nil {
+			patched, err = operations.Apply(document)
+		}
+	}
+	if err != nil {
+		errors.WriteError(w, patchError(err))
+		return
+	}
+
+	server, err := codec.fromJSON(patched)
+	if err != nil {
+		errors.WriteError(w, errors.NewBadRequestError("The patched server is invalid: "+err.Error()).
+			SetStatusCode(http.StatusUnprocessableEntity))
+		return
+	}
+	if server.ID != existing.ID {
+		errors.WriteError(w, errors.NewBadRequestError("The server ID cannot be changed"))
+		return
+	}
+	if err := checkTransition(existing.Status, server.Status); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Attachments can only be changed through the attachment endpoints
+	server.Attachments = existing.Attachments
+
+	updated, err := s.storage.UpdateServer(r.Context(), server)
+	if err != nil {
+		writeStorageError(w, "Failed to update server", err)
+		return
+	}
+
+	s.writeServer(w, r, http.StatusOK, updated)
+}
+
+// lockServerFromRequest resolves the {server} wildcard as serverFromRequest
+// does, then holds the server's lock and reads the record again, so that a
+// read-modify-write started from it cannot lose a concurrent change. The
+// request's If-Match is checked against that record. When it returns true,
+// the caller must call unlock once it has stored its change.
+func (s *Server) lockServerFromRequest(w http.ResponseWriter, r *http.Request) (server models.Server, unlock func(), ok bool) {
+	found, ok := s.serverFromRequest(w, r, http.StatusPermanentRedirect)
+	if !ok {
+		return models.Server{}, nil, false
+	}
+
+	server, unlock, err := s.lockedServer(r.Context(), found.ID)
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve server", err)
+		return models.Server{}, nil, false
+	}
+	if err := s.checkIfMatch(r, server); err != nil {
+		unlock()
+		errors.WriteError(w, err)
+		return models.Server{}, nil, false
+	}
+
+	return server, unlock, true
+}
+
+// lockedServer holds the lock of the server with the given ID and reads its
+// record. Unless it returns an error, the caller must call unlock once it
+// has stored its change.
+func (s *Server) lockedServer(ctx context.Context, id string) (server models.Server, unlock func(), err error) {
+	unlock = s.lockServer(id)
+	server, err = s.storage.GetServer(ctx, id)
+	if err != nil {
+		unlock()
+		return models.Server{}, nil, err
+	}
+	return server, unlock, nil
+}
+
+// lockServer holds the lock of the server with the given ID until the
+// returned function is called
+func (s *Server) lockServer(id string) func() {
+	hash := fnv.New32a()
+	hash.Write([]byte(id))
+	lock := &s.serverLocks[hash.Sum32()%uint32(len(s.serverLocks))]
+	lock.Lock()
+	return lock.Unlock
+}
+
+// checkIfMatch answers 412 when the request's If-Match names none of the
+// ETags of the server as it is now. The ETags of compressed responses match
+// too, since they mark the same representation.
+func (s *Server) checkIfMatch(r *http.Request, server models.Server) error {
+	match := r.Header.Get("If-Match")
+	if match == "" {
+		return nil
+	}
+
+	body, err := s.encodeServer(r, server)
+	if err != nil {
+		return errors.NewInternalError("Failed to encode server", err)
+	}
+	current := strings.Trim(bodyETag(body), `"`)
+
+	for _, candidate := range strings.Split(match, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return nil
+		}
+		if strings.HasPrefix(candidate, "W/") {
+			continue // If-Match compares strongly
+		}
+		candidate = strings.Trim(candidate, `"`)
+		if candidate == current || strings.HasPrefix(candidate, current+"-") {
+			return nil
+		}
+	}
+	return errors.NewConflictError("The server has changed since it was read; fetch it again and retry").
+		SetStatusCode(http.StatusPreconditionFailed)
+}
+
+// patchError maps a failure to apply a patch onto the response it deserves:
+// a malformed patch is a bad request, a failed test a conflict with the
+// current state and an operation that does not fit the document
+// unprocessable
+func patchError(err error) error {
+	switch {
+	case stderrors.Is(err, patch.ErrTestFailed):
+		return errors.NewConflictError("Patch test failed: " + err.Error())
+	case stderrors.Is(err, patch.ErrNotApplicable):
+		return errors.NewBadRequestError(err.Error()).SetStatusCode(http.StatusUnprocessableEntity)
+	}
+	return errors.NewBadRequestError(err.Error())
+}
+
+// DeleteServer removes a server and the attachment content no other server
+// shares. Servers that are still referenced cannot be deleted.
+func (s *Server) DeleteServer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	server, ok := s.serverFromRequest(w, r, http.StatusPermanentRedirect)
+	if !ok {
+		return
+	}
+
+	if err := s.storage.DeleteServer(ctx, server.ID); err != nil {
+		writeStorageError(w, "Failed to delete server", err)
+		return
+	}
+
+	for _, attachment := range server.Attachments {
+		s.releaseBlob(ctx, attachment.SHA256)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ChangeServerStatus moves a server through the approval workflow
+func (s *Server) ChangeServerStatus(w http.ResponseWriter, r *http.Request) {
+	server, unlock, ok := s.lockServerFromRequest(w, r)
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	var change statusChange
+	if err := readJSON(w, r, &change); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+	if err := checkTransition(server.Status, change.Status); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	server.Status = change.Status
+	updated, err := s.storage.UpdateServer(r.Context(), server)
+	if err != nil {
+		writeStorageError(w, "Failed to change server status", err)
+		return
+	}
+
+	s.writeServer(w, r, http.StatusOK, updated)
+}
+
+// checkTransition rejects status changes the approval workflow does not
+// allow. Unknown statuses are left to validation.
+func checkTransition(from, to string) error {
+	if _, known := models.StatusTransitions[to]; !known || models.CanTransition(from, to) {
+		return nil
+	}
+	return errors.NewConflictError(fmt.Sprintf("Cannot change status from %s to %s", from, to))
+}
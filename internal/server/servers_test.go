@@ -0,0 +1,220 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/patch"
+)
+
+const alphaBody = `{"name":"Alpha","description":"first","transport":"stdio","status":"new"}`
+
+func TestServers_CreateGetDelete(t *testing.T) {
+	s := newTestServer(t)
+
+	rec := send(s, http.MethodPost, "/api/servers/v1", alphaBody)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body)
+	}
+	if location := rec.Header().Get("Location"); location != "/api/servers/v1/alpha" {
+		t.Errorf("expected the location of the new server, got %q", location)
+	}
+	var created models.Server
+	json.Unmarshal(rec.Body.Bytes(), &created)
+	if created.ID == "" || created.Slug != "alpha" {
+		t.Errorf("expected keys to be assigned, got %+v", created)
+	}
+
+	if rec := send(s, http.MethodGet, "/api/servers/v1/"+created.ID, ""); rec.Code != http.StatusOK {
+		t.Errorf("expected the server by ID, got %d", rec.Code)
+	}
+
+	if rec := send(s, http.MethodDelete, "/api/servers/v1/alpha", ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", rec.Code, rec.Body)
+	}
+	if rec := send(s, http.MethodGet, "/api/servers/v1/alpha", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 once deleted, got %d", rec.Code)
+	}
+	if rec := send(s, http.MethodDelete, "/api/servers/v1/alpha", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 deleting again, got %d", rec.Code)
+	}
+}
+
+func TestServers_RejectBadBodies(t *testing.T) {
+	s := newTestServer(t)
+
+	rec := send(s, http.MethodPost, "/api/servers/v1", `{"name":"Alpha","description":"first","transport":"stdio","status":"new","colour":"red"}`)
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "colour") {
+		t.Errorf("expected 400 naming the unknown field, got %d: %s", rec.Code, rec.Body)
+	}
+
+	oversized := `{"name":"Alpha","description":"` + strings.Repeat("a", maxRequestBodyBytes) + `"}`
+	if rec := send(s, http.MethodPost, "/api/servers/v1", oversized); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413, got %d", rec.Code)
+	}
+
+	if rec := send(s, http.MethodPut, "/api/servers/v1/missing", alphaBody); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 replacing an unknown server, got %d", rec.Code)
+	}
+}
+
+func TestServers_StatusTransitions(t *testing.T) {
+	s := newTestServer(t)
+	send(s, http.MethodPost, "/api/servers/v1", alphaBody)
+
+	rec := send(s, http.MethodPost, "/api/servers/v1/alpha/status", `{"status":"approved"}`)
+	if rec.Code != http.StatusConflict {
+		t.Errorf("expected 409 skipping review, got %d: %s", rec.Code, rec.Body)
+	}
+
+	rec = send(s, http.MethodPost, "/api/servers/v1/alpha/status", `{"status":"in-review"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+	}
+	var updated models.Server
+	json.Unmarshal(rec.Body.Bytes(), &updated)
+	if updated.Status != models.StatusInReview {
+		t.Errorf("expected the new status, got %q", updated.Status)
+	}
+
+	if rec := send(s, http.MethodPost, "/api/servers/v1/missing/status", `{"status":"in-review"}`); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown server, got %d", rec.Code)
+	}
+}
+
+func patchServer(s *Server, path, ifMatch, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPatch, path, strings.NewReader(body))
+	r.Header.Set("Content-Type", patch.MergePatchType)
+	if ifMatch != "" {
+		r.Header.Set("If-Match", ifMatch)
+	}
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, r)
+	return rec
+}
+
+// TestServers_ConcurrentPatchesAllApply sends merge patches that each add a
+// config entry at once, none of which may be lost
+func TestServers_ConcurrentPatchesAllApply(t *testing.T) {
+	s := newTestServer(t)
+	send(s, http.MethodPost, "/api/servers/v1", alphaBody)
+
+	const patches = 50
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < patches; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			rec := patchServer(s, "/api/servers/v1/alpha", "", fmt.Sprintf(`{"config":{"key%d":{"command":"server"}}}`, i))
+			if rec.Code != http.StatusOK {
+				t.Errorf("expected 200, got %d: %s", rec.Code, rec.Body)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	server, err := s.storage.GetServerBySlug(context.Background(), "alpha")
+	if err != nil {
+		t.Fatalf("retrieving server: %v", err)
+	}
+	if len(server.Config) != patches {
+		t.Errorf("expected all %d config entries, got %d", patches, len(server.Config))
+	}
+}
+
+func TestServers_PatchChecksIfMatch(t *testing.T) {
+	s := newTestServer(t)
+	send(s, http.MethodPost, "/api/servers/v1", alphaBody)
+
+	read := get(s, "/api/servers/v1/alpha", map[string]string{"Accept-Encoding": "gzip"})
+	etag := read.Header().Get("ETag")
+	if !strings.HasSuffix(etag, `-gzip"`) {
+		t.Fatalf("expected the ETag of a compressed read, got %q", etag)
+	}
+
+	rec := patchServer(s, "/api/servers/v1/alpha", etag, `{"description":"changed"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected the ETag that was read to match, got %d: %s", rec.Code, rec.Body)
+	}
+	if next := rec.Header().Get("ETag"); next == "" || next == etag {
+		t.Errorf("expected the response to carry the new ETag, got %q", next)
+	}
+
+	rec = patchServer(s, "/api/servers/v1/alpha", etag, `{"description":"lost"}`)
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Errorf("expected 412 for a stale ETag, got %d: %s", rec.Code, rec.Body)
+	}
+	if server, _ := s.storage.GetServerBySlug(context.Background(), "alpha"); server.Description != "changed" {
+		t.Errorf("expected a failed precondition to change nothing, got %q", server.Description)
+	}
+
+	if rec := patchServer(s, "/api/servers/v1/alpha", "*", `{"description":"any"}`); rec.Code != http.StatusOK {
+		t.Errorf("expected * to match an existing server, got %d", rec.Code)
+	}
+}
+
+func TestServers_RenameRedirectsOldSlug(t *testing.T) {
+	s := newTestServer(t)
+	send(s, http.MethodPost, "/api/servers/v1", alphaBody)
+
+	rec := send(s, http.MethodPut, "/api/servers/v1/alpha", `{"name":"Alpha Prime","description":"first","transport":"stdio","status":"new"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+	}
+
+	rec = send(s, http.MethodGet, "/api/servers/v1/alpha", "")
+	if rec.Code != http.StatusPermanentRedirect {
+		t.Fatalf("expected 308 for the old slug, got %d", rec.Code)
+	}
+	if location := rec.Header().Get("Location"); location != "/api/servers/v1/alpha-prime" {
+		t.Errorf("expected a redirect to the new slug, got %q", location)
+	}
+}
+
+func TestServers_ListTools(t *testing.T) {
+	s := newTestServer(t)
+	_, err := s.storage.CreateServer(context.Background(), models.Server{
+		Name:              "Alpha",
+		Description:       "first",
+		Transport:         "stdio",
+		Status:            "new",
+		Tools:             []models.Tool{{Name: "search", Description: "Search issues"}},
+		Prompts:           []models.Prompt{{Name: "triage"}},
+		ResourceTemplates: []models.ResourceTemplate{{URITemplate: "issue://{id}", Name: "issue"}},
+	})
+	if err != nil {
+		t.Fatalf("creating server: %v", err)
+	}
+	send(s, http.MethodPost, "/api/servers/v1", `{"name":"Beta","description":"second","transport":"stdio","status":"new"}`)
+
+	rec := send(s, http.MethodGet, "/api/servers/v1/alpha/tools", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+	}
+	var inventory capabilitiesResponse
+	json.Unmarshal(rec.Body.Bytes(), &inventory)
+	if len(inventory.Tools) != 1 || inventory.Tools[0].Name != "search" ||
+		len(inventory.Prompts) != 1 || inventory.Prompts[0].Name != "triage" ||
+		len(inventory.ResourceTemplates) != 1 || inventory.ResourceTemplates[0].URITemplate != "issue://{id}" {
+		t.Errorf("expected the server's inventory, got %+v", inventory)
+	}
+
+	rec = send(s, http.MethodGet, "/api/servers/v1/beta/tools", "")
+	if body := strings.TrimSpace(rec.Body.String()); body != `{"tools":[],"prompts":[],"resourceTemplates":[]}` {
+		t.Errorf("expected empty lists for a server without inventory, got %s", body)
+	}
+
+	if rec := send(s, http.MethodGet, "/api/servers/v1/missing/tools", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown server, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// serverV2 is a server as the v2 API represents it. It carries everything a
+// v1 server does, so either version can replace a record without losing
+// anything, but groups what v1 keeps flat: the source repository, the
+// license with its review and policy decision, the risk profile with its
+// assessment, and the capabilities.
+type serverV2 struct {
+	ID            string   `json:"id"`
+	Slug          string   `json:"slug"`
+	PreviousSlugs []string `json:"previousSlugs,omitempty"`
+
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Version     string    `json:"version,omitempty"`
+	Transport   string    `json:"transport"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+
+	Repository    *repositoryV2          `json:"repository,omitempty"`
+	License       *licenseV2             `json:"license,omitempty"`
+	Tags          []string               `json:"tags,omitempty"`
+	Categories    []string               `json:"categories,omitempty"`
+	Owner         *models.Ownership      `json:"owner,omitempty"`
+	Risk          *riskV2                `json:"risk,omitempty"`
+	Relationships []models.Relationship  `json:"relationships,omitempty"`
+	Inputs        []models.Input         `json:"inputs,omitempty"`
+	Attachments   []models.Attachment    `json:"attachments,omitempty"`
+	Config        map[string]interface{} `json:"config,omitempty"`
+	Capabilities  *capabilitiesV2        `json:"capabilities,omitempty"`
+}
+
+// repositoryV2 is where the source of a server lives
+type repositoryV2 struct {
+	URL string `json:"url"`
+}
+
+// licenseV2 is the license of a server. Decision is computed when the
+// server is read.
+type licenseV2 struct {
+	Expression string                `json:"expression,omitempty"`
+	Review     *models.LicenseReview `json:"review,omitempty"`
+	Decision   string                `json:"decision,omitempty"`
+}
+
+// riskV2 is the risk profile of a server. Assessment is computed from the
+// profile when the server is read.
+type riskV2 struct {
+	Profile    *models.Risk           `json:"profile,omitempty"`
+	Assessment *models.RiskAssessment `json:"assessment,omitempty"`
+}
+
+// capabilitiesV2 is what a server exposes to its clients
+type capabilitiesV2 struct {
+	Tools             []models.Tool             `json:"tools,omitempty"`
+	Prompts           []models.Prompt           `json:"prompts,omitempty"`
+	ResourceTemplates []models.ResourceTemplate `json:"resourceTemplates,omitempty"`
+}
+
+// serverListV2 documents the server list of the v2 API, whose items are
+// v2 servers
+type serverListV2 struct {
+	Items      []serverV2 `json:"items"`
+	NextCursor string     `json:"nextCursor,omitempty"`
+	Total      int        `json:"total"`
+}
+
+func toServerV2(server models.Server) serverV2 {
+	v2 := serverV2{
+		ID:            server.ID,
+		Slug:          server.Slug,
+		PreviousSlugs: server.PreviousSlugs,
+		Name:          server.Name,
+		Description:   server.Description,
+		Version:       server.Version,
+		Transport:     server.Transport,
+		Status:        server.Status,
+		CreatedAt:     server.CreatedAt,
+		UpdatedAt:     server.UpdatedAt,
+		Tags:          server.Tags,
+		Categories:    server.Categories,
+		Owner:         server.Ownership,
+		Relationships: server.Relationships,
+		Inputs:        server.Inputs,
+		Attachments:   server.Attachments,
+		Config:        server.Config,
+	}
+
+	if server.URL != "" {
+		v2.Repository = &repositoryV2{URL: server.URL}
+	}
+	if server.License != "" || server.LicenseReview != nil || server.LicenseDecision != "" {
+		v2.License = &licenseV2{Expression: server.License, Review: server.LicenseReview, Decision: server.LicenseDecision}
+	}
+	if server.Risk != nil || server.RiskAssessment != nil {
+		v2.Risk = &riskV2{Profile: server.Risk, Assessment: server.RiskAssessment}
+	}
+	if len(server.Tools) > 0 || len(server.Prompts) > 0 || len(server.ResourceTemplates) > 0 {
+		v2.Capabilities = &capabilitiesV2{Tools: server.Tools, Prompts: server.Prompts, ResourceTemplates: server.ResourceTemplates}
+	}
+
+	return v2
+}
+
+func fromServerV2(v2 serverV2) models.Server {
+	server := models.Server{
+		ID:            v2.ID,
+		Slug:          v2.Slug,
+		PreviousSlugs: v2.PreviousSlugs,
+		Name:          v2.Name,
+		Description:   v2.Description,
+		Version:       v2.Version,
+		Transport:     v2.Transport,
+		Status:        v2.Status,
+		CreatedAt:     v2.CreatedAt,
+		UpdatedAt:     v2.UpdatedAt,
+		Tags:          v2.Tags,
+		Categories:    v2.Categories,
+		Ownership:     v2.Owner,
+		Relationships: v2.Relationships,
+		Inputs:        v2.Inputs,
+		Attachments:   v2.Attachments,
+		Config:        v2.Config,
+	}
+
+	if v2.Repository != nil {
+		server.URL = v2.Repository.URL
+	}
+	if v2.License != nil {
+		server.License = v2.License.Expression
+		server.LicenseReview = v2.License.Review
+		server.LicenseDecision = v2.License.Decision
+	}
+	if v2.Risk != nil {
+		server.Risk = v2.Risk.Profile
+		server.RiskAssessment = v2.Risk.Assessment
+	}
+	if v2.Capabilities != nil {
+		server.Tools = v2.Capabilities.Tools
+		server.Prompts = v2.Capabilities.Prompts
+		server.ResourceTemplates = v2.Capabilities.ResourceTemplates
+	}
+
+	return server
+}
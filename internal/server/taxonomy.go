@@ -0,0 +1,531 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/storage"
+)
+
+// collectionView is a collection with its servers resolved, in curated order
+type collectionView struct {
+	models.Collection
+	Items []models.Server `json:"items"`
+}
+
+// mergeTagsRequest lists the tags to fold into the tag named in the URL
+type mergeTagsRequest struct {
+	From []string `json:"from"`
+}
+
+// mergeTagsResponse reports the surviving tag and how many servers changed
+type mergeTagsResponse struct {
+	Tag            models.Tag `json:"tag"`
+	ServersUpdated int        `json:"serversUpdated"`
+}
+
+func (s *Server) setupTaxonomyRoutes() {
+	s.handleAPI("GET /api/tags/v1", s.ListTagsV1)
+	s.handleAdminAPI("POST /api/tags/v1", s.CreateTagV1)
+	s.handleAdminAPI("PUT /api/tags/v1/{slug}", s.UpdateTagV1)
+	s.handleAdminAPI("POST /api/tags/v1/{slug}/merge", s.MergeTagsV1)
+
+	s.handleAPI("GET /api/categories/v1", s.ListCategoriesV1)
+	s.handleAdminAPI("POST /api/categories/v1", s.CreateCategoryV1)
+
+	s.handleAPI("GET /api/collections/v1", s.ListCollectionsV1)
+	s.handleAPI("GET /api/collections/v1/{slug}", s.GetCollectionV1)
+	s.handleAdminAPI("POST /api/collections/v1", s.CreateCollectionV1)
+	s.handleAdminAPI("PUT /api/collections/v1/{slug}", s.UpdateCollectionV1)
+	s.handleAdminAPI("DELETE /api/collections/v1/{slug}", s.DeleteCollectionV1)
+}
+
+// ListTagsV1 handles retrieving the tag vocabulary
+func (s *Server) ListTagsV1(w http.ResponseWriter, r *http.Request) {
+	tags, err := s.storage.ListTags(r.Context())
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve tags", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tags)
+}
+
+// CreateTagV1 handles adding a tag to the vocabulary
+func (s *Server) CreateTagV1(w http.ResponseWriter, r *http.Request) {
+	var tag models.Tag
+	if err := readJSON(w, r, &tag); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if tag.Slug == "" {
+		tag.Slug = models.Slugify(tag.Name)
+	}
+	if err := checkTerm(tag.Slug, tag.Name); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if _, found, err := s.findTag(r.Context(), tag.Slug); err != nil {
+		writeStorageError(w, "Failed to retrieve tags", err)
+		return
+	} else if found {
+		errors.WriteError(w, errors.NewConflictError(fmt.Sprintf("Tag %q already exists", tag.Slug)))
+		return
+	}
+
+	if err := s.storage.SaveTag(r.Context(), tag); err != nil {
+		writeStorageError(w, "Failed to save tag", err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, tag)
+}
+
+// UpdateTagV1 handles renaming a tag. Changing the slug rewrites every server
+// that carries the tag.
+func (s *Server) UpdateTagV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slug := r.PathValue("slug")
+
+	var tag models.Tag
+	if err := readJSON(w, r, &tag); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if tag.Slug == "" {
+		tag.Slug = slug
+	}
+	if err := checkTerm(tag.Slug, tag.Name); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if _, found, err := s.findTag(ctx, slug); err != nil {
+		writeStorageError(w, "Failed to retrieve tags", err)
+		return
+	} else if !found {
+		errors.WriteError(w, errors.NewNotFoundError("Tag"))
+		return
+	}
+
+	if tag.Slug != slug {
+		if _, found, err := s.findTag(ctx, tag.Slug); err != nil {
+			writeStorageError(w, "Failed to retrieve tags", err)
+			return
+		} else if found {
+			errors.WriteError(w, errors.NewConflictError(fmt.Sprintf("Tag %q already exists", tag.Slug)))
+			return
+		}
+	}
+
+	var retagged []models.Server
+	if tag.Slug != slug {
+		var err error
+		if retagged, err = s.retaggedServers(ctx, []string{slug}, tag.Slug); err != nil {
+			writeStorageError(w, "Failed to check servers", err)
+			return
+		}
+	}
+
+	if err := s.storage.SaveTag(ctx, tag); err != nil {
+		writeStorageError(w, "Failed to save tag", err)
+		return
+	}
+
+	if tag.Slug != slug {
+		if err := s.saveServers(ctx, retagged); err != nil {
+			writeStorageError(w, "Failed to update servers", err)
+			return
+		}
+		if err := s.storage.DeleteTag(ctx, slug); err != nil {
+			writeStorageError(w, "Failed to delete tag", err)
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, tag)
+}
+
+// MergeTagsV1 handles folding one or more tags into the tag named in the URL.
+// Servers carrying a merged tag get the surviving tag instead, and the merged
+// tags are removed from the vocabulary.
+func (s *Server) MergeTagsV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slug := r.PathValue("slug")
+
+	var req mergeTagsRequest
+	if err := readJSON(w, r, &req); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+	if len(req.From) == 0 {
+		errors.WriteError(w, errors.NewBadRequestError("At least one tag to merge is required"))
+		return
+	}
+	if slices.Contains(req.From, slug) {
+		errors.WriteError(w, errors.NewBadRequestError("A tag cannot be merged into itself"))
+		return
+	}
+
+	target, found, err := s.findTag(ctx, slug)
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve tags", err)
+		return
+	} else if !found {
+		errors.WriteError(w, errors.NewNotFoundError("Tag"))
+		return
+	}
+
+	for _, from := range req.From {
+		if _, found, err := s.findTag(ctx, from); err != nil {
+			writeStorageError(w, "Failed to retrieve tags", err)
+			return
+		} else if !found {
+			errors.WriteError(w, errors.NewNotFoundError(fmt.Sprintf("Tag %q", from)))
+			return
+		}
+	}
+
+	retagged, err := s.retaggedServers(ctx, req.From, slug)
+	if err != nil {
+		writeStorageError(w, "Failed to check servers", err)
+		return
+	}
+	if err := s.saveServers(ctx, retagged); err != nil {
+		writeStorageError(w, "Failed to update servers", err)
+		return
+	}
+
+	for _, from := range req.From {
+		if err := s.storage.DeleteTag(ctx, from); err != nil {
+			writeStorageError(w, "Failed to delete tag", err)
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, mergeTagsResponse{Tag: target, ServersUpdated: len(retagged)})
+}
+
+// ListCategoriesV1 handles retrieving the category vocabulary
+func (s *Server) ListCategoriesV1(w http.ResponseWriter, r *http.Request) {
+	categories, err := s.storage.ListCategories(r.Context())
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve categories", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, categories)
+}
+
+// CreateCategoryV1 handles adding a category to the vocabulary
+func (s *Server) CreateCategoryV1(w http.ResponseWriter, r *http.Request) {
+	var category models.Category
+	if err := readJSON(w, r, &category); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if category.Slug == "" {
+		category.Slug = models.Slugify(category.Name)
+	}
+	if err := checkTerm(category.Slug, category.Name); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	categories, err := s.storage.ListCategories(r.Context())
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve categories", err)
+		return
+	}
+	for _, existing := range categories {
+		if existing.Slug == category.Slug {
+			errors.WriteError(w, errors.NewConflictError(fmt.Sprintf("Category %q already exists", category.Slug)))
+			return
+		}
+	}
+
+	if err := s.storage.SaveCategory(r.Context(), category); err != nil {
+		writeStorageError(w, "Failed to save category", err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, category)
+}
+
+// ListCollectionsV1 handles retrieving all curated collections
+func (s *Server) ListCollectionsV1(w http.ResponseWriter, r *http.Request) {
+	collections, err := s.storage.ListCollections(r.Context())
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve collections", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, collections)
+}
+
+// GetCollectionV1 handles retrieving a collection with its servers resolved
+func (s *Server) GetCollectionV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	collection, found, err := s.findCollection(ctx, r.PathValue("slug"))
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve collections", err)
+		return
+	} else if !found {
+		errors.WriteError(w, errors.NewNotFoundError("Collection"))
+		return
+	}
+
+	servers, err := s.storage.ListServers(ctx)
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve servers", err)
+		return
+	}
+	s.assessServers(servers)
+
+	writeJSON(w, http.StatusOK, resolveCollection(collection, servers))
+}
+
+// CreateCollectionV1 handles creating a curated collection
+func (s *Server) CreateCollectionV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var collection models.Collection
+	if err := readJSON(w, r, &collection); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if collection.Slug == "" {
+		collection.Slug = models.Slugify(collection.Name)
+	}
+	if err := s.checkCollection(ctx, &collection); err != nil {
+		writeStorageError(w, "Failed to retrieve servers", err)
+		return
+	}
+
+	if _, found, err := s.findCollection(ctx, collection.Slug); err != nil {
+		writeStorageError(w, "Failed to retrieve collections", err)
+		return
+	} else if found {
+		errors.WriteError(w, errors.NewConflictError(fmt.Sprintf("Collection %q already exists", collection.Slug)))
+		return
+	}
+
+	collection.UpdatedAt = time.Now().UTC()
+	if err := s.storage.SaveCollection(ctx, collection); err != nil {
+		writeStorageError(w, "Failed to save collection", err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, collection)
+}
+
+// UpdateCollectionV1 handles replacing a collection's name, description and
+// server list. The slug cannot be changed.
+func (s *Server) UpdateCollectionV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slug := r.PathValue("slug")
+
+	var collection models.Collection
+	if err := readJSON(w, r, &collection); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if collection.Slug != "" && collection.Slug != slug {
+		errors.WriteError(w, errors.NewBadRequestError("Collection slug cannot be changed"))
+		return
+	}
+	collection.Slug = slug
+
+	if _, found, err := s.findCollection(ctx, slug); err != nil {
+		writeStorageError(w, "Failed to retrieve collections", err)
+		return
+	} else if !found {
+		errors.WriteError(w, errors.NewNotFoundError("Collection"))
+		return
+	}
+
+	if err := s.checkCollection(ctx, &collection); err != nil {
+		writeStorageError(w, "Failed to retrieve servers", err)
+		return
+	}
+
+	collection.UpdatedAt = time.Now().UTC()
+	if err := s.storage.SaveCollection(ctx, collection); err != nil {
+		writeStorageError(w, "Failed to save collection", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, collection)
+}
+
+// DeleteCollectionV1 handles removing a curated collection
+func (s *Server) DeleteCollectionV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	collection, found, err := s.findCollection(ctx, r.PathValue("slug"))
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve collections", err)
+		return
+	} else if !found {
+		errors.WriteError(w, errors.NewNotFoundError("Collection"))
+		return
+	}
+
+	if err := s.storage.DeleteCollection(ctx, collection.Slug); err != nil {
+		writeStorageError(w, "Failed to delete collection", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkTerm validates the slug and name shared by tags and categories
+func checkTerm(slug, name string) error {
+	if name == "" {
+		return errors.NewBadRequestError("Name is required")
+	}
+	if slug == "" || models.Slugify(slug) != slug {
+		return errors.NewBadRequestError("Slug must contain only lower-case letters, digits and hyphens")
+	}
+	return nil
+}
+
+// checkCollection validates a collection and resolves the servers it lists,
+// given by ID or slug, to their IDs
+func (s *Server) checkCollection(ctx context.Context, collection *models.Collection) error {
+	if err := checkTerm(collection.Slug, collection.Name); err != nil {
+		return err
+	}
+
+	var ids, missing []string
+	for _, ref := range collection.Servers {
+		server, _, err := s.lookupServer(ctx, ref)
+		if isNotFound(err) {
+			missing = append(missing, ref)
+			continue
+		} else if err != nil {
+			return err
+		}
+		ids = append(ids, server.ID)
+	}
+
+	if len(missing) > 0 {
+		return errors.NewValidationError("Collection references unknown servers", missing)
+	}
+
+	collection.Servers = ids
+	return nil
+}
+
+func (s *Server) findTag(ctx context.Context, slug string) (models.Tag, bool, error) {
+	tags, err := s.storage.ListTags(ctx)
+	if err != nil {
+		return models.Tag{}, false, err
+	}
+
+	for _, tag := range tags {
+		if tag.Slug == slug {
+			return tag, true, nil
+		}
+	}
+
+	return models.Tag{}, false, nil
+}
+
+func (s *Server) findCollection(ctx context.Context, slug string) (models.Collection, bool, error) {
+	collections, err := s.storage.ListCollections(ctx)
+	if err != nil {
+		return models.Collection{}, false, err
+	}
+
+	for _, collection := range collections {
+		if collection.Slug == slug {
+			return collection, true, nil
+		}
+	}
+
+	return models.Collection{}, false, nil
+}
+
+// retaggedServers returns every server that carries any of the given tags,
+// with them replaced by a single tag. Each server is first checked with a dry
+// run of its update, as the import does, so that a rename or merge is refused
+// before anything is written rather than left half done. The new tag is in
+// the vocabulary by the time the servers are saved, so it is the rest of the
+// record, stored under earlier rules, that is checked.
+func (s *Server) retaggedServers(ctx context.Context, from []string, to string) ([]models.Server, error) {
+	servers, err := s.storage.ListServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun := storage.WithDryRun(ctx)
+	var retagged []models.Server
+	for _, server := range servers {
+		if !slices.ContainsFunc(server.Tags, func(tag string) bool { return slices.Contains(from, tag) }) {
+			continue
+		}
+
+		if _, err := s.storage.UpdateServer(dryRun, server); err != nil {
+			if appErr, ok := err.(*errors.AppError); ok && appErr.Type == errors.ErrorTypeValidation {
+				return nil, appErr.
+					SetUserMessage(fmt.Sprintf("Server %q no longer passes validation; fix it before changing its tags", server.Name)).
+					SetStatusCode(http.StatusConflict)
+			}
+			return nil, err
+		}
+
+		var tags []string
+		for _, tag := range server.Tags {
+			if slices.Contains(from, tag) {
+				tag = to
+			}
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		server.Tags = tags
+		retagged = append(retagged, server)
+	}
+
+	return retagged, nil
+}
+
+// saveServers writes servers returned by retaggedServers
+func (s *Server) saveServers(ctx context.Context, servers []models.Server) error {
+	for _, server := range servers {
+		if _, err := s.storage.UpdateServer(ctx, server); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveCollection looks up the collection's servers, keeping the curated
+// order and skipping entries that no longer exist
+func resolveCollection(collection models.Collection, servers []models.Server) collectionView {
+	view := collectionView{Collection: collection, Items: []models.Server{}}
+
+	for _, id := range collection.Servers {
+		for _, server := range servers {
+			if server.ID == id {
+				view.Items = append(view.Items, server)
+				break
+			}
+		}
+	}
+
+	return view
+}
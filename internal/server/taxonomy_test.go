@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/storage"
+)
+
+// createTaggedServer adds the tags to the vocabulary and creates a server
+// carrying them
+func createTaggedServer(t *testing.T, s *Server, name string, tags ...string) models.Server {
+	t.Helper()
+	ctx := context.Background()
+
+	for _, tag := range tags {
+		if _, found, _ := s.findTag(ctx, tag); !found {
+			if err := s.storage.SaveTag(ctx, models.Tag{Slug: tag, Name: tag}); err != nil {
+				t.Fatalf("saving tag: %v", err)
+			}
+		}
+	}
+	server, err := s.storage.CreateServer(ctx, models.Server{Name: name, Description: name, Transport: "stdio", Status: "new", Tags: tags})
+	if err != nil {
+		t.Fatalf("creating server: %v", err)
+	}
+	return server
+}
+
+func tagSlugs(t *testing.T, s *Server) []string {
+	t.Helper()
+
+	tags, err := s.storage.ListTags(context.Background())
+	if err != nil {
+		t.Fatalf("listing tags: %v", err)
+	}
+	var slugs []string
+	for _, tag := range tags {
+		slugs = append(slugs, tag.Slug)
+	}
+	return slugs
+}
+
+func TestUpdateTag_RenameRetagsServers(t *testing.T) {
+	s := newTestServer(t)
+	alpha := createTaggedServer(t, s, "Alpha", "git", "ci")
+	beta := createTaggedServer(t, s, "Beta", "ci")
+
+	rec := send(s, http.MethodPut, "/api/tags/v1/git", `{"slug":"ci","name":"CI"}`)
+	if rec.Code != http.StatusConflict {
+		t.Errorf("expected 409 renaming onto an existing tag, got %d: %s", rec.Code, rec.Body)
+	}
+
+	rec = send(s, http.MethodPut, "/api/tags/v1/git", `{"slug":"source-control","name":"Source control"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+	}
+
+	ctx := context.Background()
+	if got, _ := s.storage.GetServer(ctx, alpha.ID); !slices.Equal(got.Tags, []string{"source-control", "ci"}) {
+		t.Errorf("expected alpha to be retagged in place, got %v", got.Tags)
+	}
+	if got, _ := s.storage.GetServer(ctx, beta.ID); !slices.Equal(got.Tags, []string{"ci"}) {
+		t.Errorf("expected beta to be untouched, got %v", got.Tags)
+	}
+	if slugs := tagSlugs(t, s); slices.Contains(slugs, "git") || !slices.Contains(slugs, "source-control") {
+		t.Errorf("expected git to be replaced by source-control, got %v", slugs)
+	}
+}
+
+func TestUpdateTag_RenameChangesNothingWhenAServerFailsValidation(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	alpha := createTaggedServer(t, s, "Alpha", "git")
+	beta := createTaggedServer(t, s, "Beta", "git")
+	createTaggedServer(t, s, "Gamma", "git")
+	if err := s.storage.SaveTag(ctx, models.Tag{Slug: "ci", Name: "CI"}); err != nil {
+		t.Fatalf("saving tag: %v", err)
+	}
+
+	// Store a tag that has since left the vocabulary, as an older release
+	// could have, underneath the validation
+	beta.Tags = []string{"git", "retired"}
+	files := s.catalog.Storage.(*storage.ValidatingStorage).Storage
+	if _, err := files.UpdateServer(ctx, beta); err != nil {
+		t.Fatalf("storing stale server: %v", err)
+	}
+
+	for _, req := range []struct{ method, path, body string }{
+		{http.MethodPut, "/api/tags/v1/git", `{"slug":"source-control","name":"Source control"}`},
+		{http.MethodPost, "/api/tags/v1/ci/merge", `{"from":["git"]}`},
+	} {
+		rec := send(s, req.method, req.path, req.body)
+		if rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), "Beta") {
+			t.Errorf("%s %s: expected 409 naming the stale server, got %d: %s", req.method, req.path, rec.Code, rec.Body)
+		}
+
+		if got, _ := s.storage.GetServer(ctx, alpha.ID); !slices.Equal(got.Tags, []string{"git"}) {
+			t.Errorf("%s %s: expected alpha to keep its tag, got %v", req.method, req.path, got.Tags)
+		}
+		if slugs := tagSlugs(t, s); !slices.Contains(slugs, "git") || slices.Contains(slugs, "source-control") {
+			t.Errorf("%s %s: expected the vocabulary to be unchanged, got %v", req.method, req.path, slugs)
+		}
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	s := newTestServer(t)
+	alpha := createTaggedServer(t, s, "Alpha", "github", "gitlab")
+	createTaggedServer(t, s, "Beta", "git")
+
+	rec := send(s, http.MethodPost, "/api/tags/v1/git/merge", `{"from":["git"]}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 merging a tag into itself, got %d: %s", rec.Code, rec.Body)
+	}
+	rec = send(s, http.MethodPost, "/api/tags/v1/git/merge", `{"from":["github","bitbucket"]}`)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown source tag, got %d: %s", rec.Code, rec.Body)
+	}
+	if slugs := tagSlugs(t, s); !slices.Contains(slugs, "github") {
+		t.Errorf("expected a rejected merge to change nothing, got %v", slugs)
+	}
+
+	rec = send(s, http.MethodPost, "/api/tags/v1/git/merge", `{"from":["github","gitlab"]}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+	}
+	var merged mergeTagsResponse
+	json.Unmarshal(rec.Body.Bytes(), &merged)
+	if merged.Tag.Slug != "git" || merged.ServersUpdated != 1 {
+		t.Errorf("expected one server to be updated, got %+v", merged)
+	}
+
+	if got, _ := s.storage.GetServer(context.Background(), alpha.ID); !slices.Equal(got.Tags, []string{"git"}) {
+		t.Errorf("expected the merged tags to collapse into one, got %v", got.Tags)
+	}
+	if slugs := tagSlugs(t, s); !slices.Equal(slugs, []string{"git"}) {
+		t.Errorf("expected only the surviving tag, got %v", slugs)
+	}
+}
+
+func TestCollections_CreateUpdateDelete(t *testing.T) {
+	s := newTestServer(t)
+	alpha := createTaggedServer(t, s, "Alpha")
+	beta := createTaggedServer(t, s, "Beta")
+
+	rec := send(s, http.MethodPost, "/api/collections/v1", `{"name":"Backend picks","servers":["beta","`+alpha.ID+`"]}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body)
+	}
+	var collection models.Collection
+	json.Unmarshal(rec.Body.Bytes(), &collection)
+	if collection.Slug != "backend-picks" || !slices.Equal(collection.Servers, []string{beta.ID, alpha.ID}) {
+		t.Errorf("expected the slug to be derived and servers resolved to IDs, got %+v", collection)
+	}
+
+	if rec := send(s, http.MethodPost, "/api/collections/v1", `{"name":"Backend picks"}`); rec.Code != http.StatusConflict {
+		t.Errorf("expected 409 for a duplicate slug, got %d", rec.Code)
+	}
+	if rec := send(s, http.MethodPost, "/api/collections/v1", `{"name":"Missing","servers":["nope"]}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an unknown server, got %d", rec.Code)
+	}
+
+	rec = send(s, http.MethodPut, "/api/collections/v1/backend-picks", `{"name":"Backend picks","servers":["alpha"]}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+	}
+	if rec := send(s, http.MethodPut, "/api/collections/v1/backend-picks", `{"slug":"other","name":"Other"}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 changing the slug, got %d", rec.Code)
+	}
+
+	rec = send(s, http.MethodGet, "/api/collections/v1/backend-picks", "")
+	var view collectionView
+	json.Unmarshal(rec.Body.Bytes(), &view)
+	if len(view.Items) != 1 || view.Items[0].ID != alpha.ID {
+		t.Errorf("expected the update to replace the servers, got %+v", view.Items)
+	}
+
+	if rec := send(s, http.MethodDelete, "/api/collections/v1/..%2F"+alpha.ID, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for a slug naming no collection, got %d", rec.Code)
+	}
+	if _, err := s.storage.GetServer(context.Background(), alpha.ID); err != nil {
+		t.Errorf("expected the server record to survive, got %v", err)
+	}
+
+	if rec := send(s, http.MethodDelete, "/api/collections/v1/backend-picks", ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", rec.Code, rec.Body)
+	}
+	if rec := send(s, http.MethodGet, "/api/collections/v1/backend-picks", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("expected the collection to be gone, got %d", rec.Code)
+	}
+}
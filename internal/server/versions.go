@@ -0,0 +1,232 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/logger"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// apiVersion is a version of the server API. Every version is served from
+// the same handlers and storage; what sets one apart is how its codec
+// represents servers.
+type apiVersion struct {
+	Name string
+
+	// Successor is the version that replaces this one once it is deprecated
+	Successor string
+
+	// schedule returns when the configuration deprecates the version and
+	// when it is expected to stop being served. Both are zero for supported
+	// versions, and versions without a schedule are always supported.
+	// Responses of a deprecated version carry them as the Deprecation
+	// (RFC 9745) and Sunset (RFC 8594) headers, with a link to Successor.
+	schedule func(config models.Config) (deprecated, sunset time.Time)
+
+	codec serverCodec
+
+	// serverShape and listShape are zero values of the version's server and
+	// server list, for the OpenAPI document
+	serverShape any
+	listShape   any
+}
+
+var (
+	apiV1 = &apiVersion{
+		Name:      "v1",
+		Successor: "v2",
+		schedule: func(config models.Config) (time.Time, time.Time) {
+			return config.V1Deprecated, config.V1Sunset
+		},
+
+		codec:       serverCodecV1,
+		serverShape: models.Server{},
+		listShape:   serverList{},
+	}
+	apiV2 = &apiVersion{
+		Name: "v2",
+
+		codec:       jsonCodec[serverV2]{encode: toServerV2, decode: fromServerV2},
+		serverShape: serverV2{},
+		listShape:   serverListV2{},
+	}
+)
+
+// apiVersions lists the versions of the server API, oldest first
+var apiVersions = []*apiVersion{apiV1, apiV2}
+
+// deprecation returns when the version is deprecated and sunset under the
+// configuration, both zero while it is supported
+func (v *apiVersion) deprecation(config models.Config) (deprecated, sunset time.Time) {
+	if v.schedule == nil {
+		return time.Time{}, time.Time{}
+	}
+	return v.schedule(config)
+}
+
+// serversPath is the path of the server collection in this version
+func (v *apiVersion) serversPath() string {
+	return "/api/servers/" + v.Name
+}
+
+// serverPath is the canonical URL of a server in this version
+func (v *apiVersion) serverPath(server models.Server) string {
+	return v.serversPath() + "/" + server.Slug
+}
+
+// serverCodec translates between stored servers and the representation of
+// an API version. Decoding rejects unknown members, as readJSON does.
+type serverCodec interface {
+	toJSON(server models.Server) any
+	fromJSON(data []byte) (models.Server, error)
+	read(w http.ResponseWriter, r *http.Request) (models.Server, error)
+	fieldNames() []string
+}
+
+// serverCodecV1 represents servers as they are stored. The list handlers of
+// the other APIs rely on it to page models.Server values.
+var serverCodecV1 serverCodec = jsonCodec[models.Server]{
+	encode: func(server models.Server) models.Server { return server },
+	decode: func(server models.Server) models.Server { return server },
+}
+
+// jsonCodec represents servers as JSON values of type T
+type jsonCodec[T any] struct {
+	encode func(models.Server) T
+	decode func(T) models.Server
+}
+
+func (c jsonCodec[T]) toJSON(server models.Server) any {
+	return c.encode(server)
+}
+
+func (c jsonCodec[T]) fromJSON(data []byte) (models.Server, error) {
+	var value T
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&value); err != nil {
+		return models.Server{}, err
+	}
+	return c.decode(value), nil
+}
+
+func (c jsonCodec[T]) read(w http.ResponseWriter, r *http.Request) (models.Server, error) {
+	var value T
+	if err := readJSON(w, r, &value); err != nil {
+		return models.Server{}, err
+	}
+	return c.decode(value), nil
+}
+
+func (c jsonCodec[T]) fieldNames() []string {
+	return jsonFieldNames(reflect.TypeFor[T]())
+}
+
+type apiVersionKey struct{}
+
+// apiVersionFrom returns the API version a request was made to, v1 for
+// requests that did not come through a versioned route
+func apiVersionFrom(ctx context.Context) *apiVersion {
+	if version, ok := ctx.Value(apiVersionKey{}).(*apiVersion); ok {
+		return version
+	}
+	return apiV1
+}
+
+// handleServerAPI registers a route of the server API under every version.
+// The pattern's path is relative to the server collection, so that
+// "GET /{server}" serves GET /api/servers/v1/{server}, and so on.
+func (s *Server) handleServerAPI(pattern string, handler http.HandlerFunc) {
+	method, path, _ := strings.Cut(pattern, " ")
+	for _, version := range apiVersions {
+		s.handleAPI(method+" "+version.serversPath()+path, s.versionMiddleware(version, handler))
+	}
+}
+
+// handleServerAdminAPI registers a route of the server API that requires
+// the admin token under every version
+func (s *Server) handleServerAdminAPI(pattern string, handler http.HandlerFunc) {
+	method, path, _ := strings.Cut(pattern, " ")
+	for _, version := range apiVersions {
+		s.handleAdminAPI(method+" "+version.serversPath()+path, s.versionMiddleware(version, handler))
+	}
+}
+
+// versionMiddleware makes the version known to the handler, counts its use
+// and signals its deprecation. Calls to a deprecated version are logged with
+// the client, so that its callers can be found before the sunset.
+func (s *Server) versionMiddleware(version *apiVersion, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.versionUsage[version.Name].Add(1)
+
+		if deprecated, sunset := version.deprecation(s.config); !deprecated.IsZero() {
+			header := w.Header()
+			header.Set("Deprecation", fmt.Sprintf("@%d", deprecated.Unix()))
+			if !sunset.IsZero() {
+				header.Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+			}
+			if version.Successor != "" {
+				successor := strings.Replace(r.URL.Path, version.serversPath(), "/api/servers/"+version.Successor, 1)
+				header.Add("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, successor))
+			}
+
+			logger.Warn("Deprecated API version called",
+				"version", version.Name, "route", r.Pattern, "userAgent", r.UserAgent(), "remoteAddr", r.RemoteAddr)
+		}
+
+		next(w, r.WithContext(context.WithValue(r.Context(), apiVersionKey{}, version)))
+	}
+}
+
+// apiVersionStatus describes a version of the server API and how much it
+// has been used
+type apiVersionStatus struct {
+	Version    string     `json:"version"`
+	Path       string     `json:"path"`
+	Deprecated *time.Time `json:"deprecated,omitempty"`
+	Sunset     *time.Time `json:"sunset,omitempty"`
+	Successor  string     `json:"successor,omitempty"`
+	Requests   int64      `json:"requests"`
+}
+
+// ListAPIVersions lists the versions of the server API with their
+// deprecation schedule and the requests each has served since the registry
+// started
+func (s *Server) ListAPIVersions(w http.ResponseWriter, r *http.Request) {
+	statuses := make([]apiVersionStatus, 0, len(apiVersions))
+	for _, version := range apiVersions {
+		status := apiVersionStatus{
+			Version:   version.Name,
+			Path:      version.serversPath(),
+			Successor: version.Successor,
+			Requests:  s.versionUsage[version.Name].Load(),
+		}
+		deprecated, sunset := version.deprecation(s.config)
+		if !deprecated.IsZero() {
+			status.Deprecated = &deprecated
+		}
+		if !sunset.IsZero() {
+			status.Sunset = &sunset
+		}
+		statuses = append(statuses, status)
+	}
+
+	writeJSON(w, http.StatusOK, statuses)
+}
+
+// newVersionUsage returns a request counter for every API version
+func newVersionUsage() map[string]*atomic.Int64 {
+	usage := make(map[string]*atomic.Int64, len(apiVersions))
+	for _, version := range apiVersions {
+		usage[version.Name] = new(atomic.Int64)
+	}
+	return usage
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// TestServerV2_CarriesEveryField round-trips a server with every field set
+// through the v2 representation, so a field added to models.Server cannot be
+// lost by clients of v2 replacing records
+func TestServerV2_CarriesEveryField(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	server := models.Server{
+		ID:                "id-1",
+		Slug:              "github",
+		PreviousSlugs:     []string{"gh"},
+		Name:              "GitHub",
+		Description:       "Repositories",
+		Version:           "1.2.0",
+		Transport:         models.TransportStdio,
+		Status:            models.StatusApproved,
+		CreatedAt:         now,
+		UpdatedAt:         now.Add(time.Hour),
+		URL:               "https://github.com/example/github-mcp",
+		License:           "MIT",
+		LicenseReview:     &models.LicenseReview{License: "MIT", Reviewer: "legal", ReviewedAt: now},
+		Tags:              []string{"git"},
+		Categories:        []string{"developer-tools"},
+		Ownership:         &models.Ownership{Team: "platform"},
+		Risk:              &models.Risk{CanWrite: true, NetworkEgress: models.EgressInternet},
+		Relationships:     []models.Relationship{{Type: models.RelationshipTypes[0], Target: "id-2"}},
+		Inputs:            []models.Input{{Name: "token", Secret: true}},
+		Attachments:       []models.Attachment{{ID: "a-1", Name: "review.pdf"}},
+		Config:            map[string]interface{}{"command": "github-mcp"},
+		Tools:             []models.Tool{{Name: "search"}},
+		Prompts:           []models.Prompt{{Name: "triage"}},
+		ResourceTemplates: []models.ResourceTemplate{{URITemplate: "repo://{name}", Name: "repo"}},
+		RiskAssessment:    &models.RiskAssessment{Score: 40, Level: "medium"},
+		LicenseDecision:   models.LicenseDecisions[0],
+	}
+
+	fields := reflect.ValueOf(server)
+	for i := 0; i < fields.NumField(); i++ {
+		if fields.Field(i).IsZero() {
+			t.Fatalf("set %s in the fixture so that v2 is checked to carry it", fields.Type().Field(i).Name)
+		}
+	}
+
+	encoded, err := json.Marshal(toServerV2(server))
+	if err != nil {
+		t.Fatalf("encoding: %v", err)
+	}
+	decoded, err := apiV2.codec.fromJSON(encoded)
+	if err != nil {
+		t.Fatalf("decoding: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, server) {
+		t.Errorf("expected the server to survive v2 unchanged\nwant %+v\ngot  %+v", server, decoded)
+	}
+}
+
+// deprecateV1 schedules the deprecation of v1 as an operator would
+func deprecateV1(s *Server) {
+	s.config.V1Deprecated = time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	s.config.V1Sunset = time.Date(2027, time.May, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestVersions_SupportedByDefault(t *testing.T) {
+	s := newTestServer(t)
+
+	rec := send(s, http.MethodGet, "/api/servers/v1", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 from v1, got %d: %s", rec.Code, rec.Body)
+	}
+	for _, name := range []string{"Deprecation", "Sunset", "Link"} {
+		if value := rec.Header().Get(name); value != "" {
+			t.Errorf("expected no %s header, got %q", name, value)
+		}
+	}
+
+	var versions []apiVersionStatus
+	json.Unmarshal(send(s, http.MethodGet, "/api/versions", "").Body.Bytes(), &versions)
+	if len(versions) != 2 || versions[0].Deprecated != nil || versions[0].Sunset != nil {
+		t.Errorf("expected v1 to have no deprecation schedule, got %+v", versions)
+	}
+
+	if s.openAPIDocument().Paths["/api/servers/v1/{server}"].Get.Deprecated {
+		t.Errorf("expected v1 not to be documented as deprecated")
+	}
+}
+
+func TestVersions_ShareStorageAndSignalDeprecation(t *testing.T) {
+	s := newTestServer(t)
+	deprecateV1(s)
+	handler := s.Handler()
+
+	body := `{"name":"Alpha","description":"first","transport":"stdio","status":"new","repository":{"url":"https://example.com/alpha"},"license":{"expression":"MIT"}}`
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/servers/v2", strings.NewReader(body)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201 from v2, got %d: %s", rec.Code, rec.Body)
+	}
+	if location := rec.Header().Get("Location"); location != "/api/servers/v2/alpha" {
+		t.Errorf("expected a v2 location, got %q", location)
+	}
+	if rec.Header().Get("Deprecation") != "" {
+		t.Errorf("expected v2 not to be deprecated, got %q", rec.Header().Get("Deprecation"))
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/servers/v1/alpha", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 from v1, got %d: %s", rec.Code, rec.Body)
+	}
+	var v1 models.Server
+	json.Unmarshal(rec.Body.Bytes(), &v1)
+	if v1.URL != "https://example.com/alpha" || v1.License != "MIT" {
+		t.Errorf("expected v1 to read the v2 write in its own shape, got %+v", v1)
+	}
+
+	header := rec.Header()
+	if header.Get("Deprecation") != "@1793491200" || header.Get("Sunset") != "Sat, 01 May 2027 00:00:00 GMT" {
+		t.Errorf("expected Deprecation and Sunset headers, got %q and %q", header.Get("Deprecation"), header.Get("Sunset"))
+	}
+	if link := header.Get("Link"); link != `</api/servers/v2/alpha>; rel="successor-version"` {
+		t.Errorf("expected a link to the v2 resource, got %q", link)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/servers/v2?fields=repository", nil))
+	if !strings.Contains(rec.Body.String(), `"repository":{"url":"https://example.com/alpha"}`) || strings.Contains(rec.Body.String(), `"name"`) {
+		t.Errorf("expected the v2 list to project v2 fields, got %s", rec.Body)
+	}
+
+	var versions []apiVersionStatus
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/versions", nil))
+	json.Unmarshal(rec.Body.Bytes(), &versions)
+	if len(versions) != 2 || versions[0].Requests != 1 || versions[1].Requests != 2 || versions[0].Successor != "v2" {
+		t.Errorf("expected one v1 and two v2 requests, got %+v", versions)
+	}
+	if len(versions) == 2 && (versions[0].Sunset == nil || !versions[0].Sunset.Equal(s.config.V1Sunset) || versions[1].Deprecated != nil) {
+		t.Errorf("expected the configured v1 schedule, got %+v", versions)
+	}
+}
+
+func TestOpenAPI_DocumentsEveryVersion(t *testing.T) {
+	s := newTestServer(t)
+	deprecateV1(s)
+	doc := s.openAPIDocument()
+
+	v1 := doc.Paths["/api/servers/v1/{server}"].Get
+	v2 := doc.Paths["/api/servers/v2/{server}"].Get
+	if !v1.Deprecated || v2.Deprecated {
+		t.Errorf("expected only v1 to be deprecated, got %t and %t", v1.Deprecated, v2.Deprecated)
+	}
+	if !strings.Contains(v1.Description, "2027-05-01") {
+		t.Errorf("expected v1 to document its sunset, got %q", v1.Description)
+	}
+	if v2.OperationID != "getServerV2" || v2.Responses["200"].Content["application/json"].Schema.Ref != "#/components/schemas/ServerV2" {
+		t.Errorf("expected v2 to return its own representation, got %s %+v", v2.OperationID, v2.Responses["200"])
+	}
+
+	patchRef := doc.Paths["/api/servers/v2/{server}"].Patch.RequestBody.Content["application/merge-patch+json"].Schema.Ref
+	if name := strings.TrimPrefix(patchRef, "#/components/schemas/"); doc.Components.Schemas[name] == nil {
+		t.Errorf("expected the v2 merge patch to refer to a documented schema, got %q", patchRef)
+	}
+}
@@ -0,0 +1,252 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/events"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func (s *Server) setupWebhookRoutes() {
+	s.handleAdminAPI("GET /api/webhooks/v1", s.ListWebhooksV1)
+	s.handleAdminAPI("POST /api/webhooks/v1", s.CreateWebhookV1)
+	s.handleAdminAPI("GET /api/webhooks/v1/{webhook}", s.GetWebhookV1)
+	s.handleAdminAPI("PUT /api/webhooks/v1/{webhook}", s.UpdateWebhookV1)
+	s.handleAdminAPI("DELETE /api/webhooks/v1/{webhook}", s.DeleteWebhookV1)
+	s.handleAdminAPI("POST /api/webhooks/v1/{webhook}/ping", s.PingWebhookV1)
+	s.handleAdminAPI("GET /api/webhooks/v1/{webhook}/deliveries", s.ListDeliveriesV1)
+	s.handleAdminAPI("GET /api/webhooks/v1/{webhook}/deliveries/{delivery}", s.GetDeliveryV1)
+	s.handleAdminAPI("POST /api/webhooks/v1/{webhook}/deliveries/{delivery}/replay", s.ReplayDeliveryV1)
+}
+
+// StartWebhooks delivers catalog events to the registered webhooks until ctx
+// is done
+func (s *Server) StartWebhooks(ctx context.Context) {
+	go s.webhooks.Run(ctx, s.events)
+}
+
+// ListWebhooksV1 handles retrieving every webhook, without their secrets
+func (s *Server) ListWebhooksV1(w http.ResponseWriter, r *http.Request) {
+	webhooks, err := s.storage.ListWebhooks(r.Context())
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve webhooks", err)
+		return
+	}
+
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+	writeJSON(w, http.StatusOK, webhooks)
+}
+
+// CreateWebhookV1 handles registering a webhook. A secret is generated when
+// none is given; either way this response is the only one that shows it.
+func (s *Server) CreateWebhookV1(w http.ResponseWriter, r *http.Request) {
+	var webhook models.Webhook
+	if err := readJSON(w, r, &webhook); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	if err := checkWebhook(webhook); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+	if webhook.Secret == "" {
+		secret, err := newWebhookSecret()
+		if err != nil {
+			errors.WriteError(w, errors.NewInternalError("Failed to generate a secret", err))
+			return
+		}
+		webhook.Secret = secret
+	}
+
+	webhook.ID = models.NewID()
+	webhook.CreatedAt = time.Now().UTC()
+	webhook.UpdatedAt = webhook.CreatedAt
+	if err := s.storage.SaveWebhook(r.Context(), webhook); err != nil {
+		writeStorageError(w, "Failed to save webhook", err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, webhook)
+}
+
+// GetWebhookV1 handles retrieving a webhook, without its secret
+func (s *Server) GetWebhookV1(w http.ResponseWriter, r *http.Request) {
+	webhook, err := s.storage.GetWebhook(r.Context(), r.PathValue("webhook"))
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve webhook", err)
+		return
+	}
+
+	webhook.Secret = ""
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+// UpdateWebhookV1 handles replacing a webhook's URL, events and description.
+// The secret is kept unless a new one is given.
+func (s *Server) UpdateWebhookV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var webhook models.Webhook
+	if err := readJSON(w, r, &webhook); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	existing, err := s.storage.GetWebhook(ctx, r.PathValue("webhook"))
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve webhook", err)
+		return
+	}
+	if webhook.ID != "" && webhook.ID != existing.ID {
+		errors.WriteError(w, errors.NewBadRequestError("Webhook ID cannot be changed"))
+		return
+	}
+	if err := checkWebhook(webhook); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	webhook.ID = existing.ID
+	webhook.CreatedAt = existing.CreatedAt
+	webhook.UpdatedAt = time.Now().UTC()
+	if webhook.Secret == "" {
+		webhook.Secret = existing.Secret
+	}
+	if err := s.storage.SaveWebhook(ctx, webhook); err != nil {
+		writeStorageError(w, "Failed to save webhook", err)
+		return
+	}
+
+	webhook.Secret = ""
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+// DeleteWebhookV1 handles removing a webhook along with its delivery log
+func (s *Server) DeleteWebhookV1(w http.ResponseWriter, r *http.Request) {
+	if err := s.storage.DeleteWebhook(r.Context(), r.PathValue("webhook")); err != nil {
+		writeStorageError(w, "Failed to delete webhook", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PingWebhookV1 handles sending a test event to a webhook. The response is
+// the delivery after its first attempt, showing how the receiver answered.
+func (s *Server) PingWebhookV1(w http.ResponseWriter, r *http.Request) {
+	webhook, err := s.storage.GetWebhook(r.Context(), r.PathValue("webhook"))
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve webhook", err)
+		return
+	}
+
+	delivery, err := s.webhooks.Ping(r.Context(), webhook)
+	if err != nil {
+		writeStorageError(w, "Failed to log delivery", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, delivery)
+}
+
+// ListDeliveriesV1 handles retrieving a webhook's delivery log, newest first
+func (s *Server) ListDeliveriesV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	status := r.URL.Query().Get("status")
+	if status != "" && !slices.Contains(models.DeliveryStatuses, status) {
+		errors.WriteError(w, errors.NewBadRequestError("status must be one of "+strings.Join(models.DeliveryStatuses, ", ")))
+		return
+	}
+
+	webhook, err := s.storage.GetWebhook(ctx, r.PathValue("webhook"))
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve webhook", err)
+		return
+	}
+
+	deliveries, err := s.storage.ListDeliveries(ctx, webhook.ID)
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve deliveries", err)
+		return
+	}
+	if status != "" {
+		deliveries = slices.DeleteFunc(deliveries, func(d models.Delivery) bool { return d.Status != status })
+	}
+
+	writeJSON(w, http.StatusOK, deliveries)
+}
+
+// GetDeliveryV1 handles retrieving one delivery with all of its attempts
+func (s *Server) GetDeliveryV1(w http.ResponseWriter, r *http.Request) {
+	delivery, err := s.storage.GetDelivery(r.Context(), r.PathValue("webhook"), r.PathValue("delivery"))
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve delivery", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, delivery)
+}
+
+// ReplayDeliveryV1 handles sending the payload of a settled delivery again,
+// as a new delivery. The response is that delivery after its first attempt.
+func (s *Server) ReplayDeliveryV1(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	original, err := s.storage.GetDelivery(ctx, r.PathValue("webhook"), r.PathValue("delivery"))
+	if err != nil {
+		writeStorageError(w, "Failed to retrieve delivery", err)
+		return
+	}
+	if original.Status == models.DeliveryPending {
+		errors.WriteError(w, errors.NewConflictError("Delivery is still being attempted"))
+		return
+	}
+
+	delivery, err := s.webhooks.Replay(ctx, original)
+	if err != nil {
+		writeStorageError(w, "Failed to log delivery", err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, delivery)
+}
+
+// checkWebhook validates the fields of a webhook an admin sent
+func checkWebhook(webhook models.Webhook) error {
+	target, err := url.Parse(webhook.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return errors.NewBadRequestError("url must be an absolute http or https URL")
+	}
+
+	var unknown []string
+	for _, eventType := range webhook.Events {
+		if !slices.Contains(events.Types, eventType) {
+			unknown = append(unknown, eventType)
+		}
+	}
+	if len(unknown) > 0 {
+		return errors.NewValidationError("Unknown event types, expected "+strings.Join(events.Types, ", "), unknown)
+	}
+
+	return nil
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
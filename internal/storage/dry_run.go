@@ -0,0 +1,18 @@
+package storage
+
+import "context"
+
+type dryRunKey struct{}
+
+// WithDryRun returns a context under which server writes run every check,
+// including validation and conflicts, and return the record that would have
+// been stored without storing it
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether writes under ctx should be checked but not stored
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
@@ -9,7 +9,7 @@ import (
 func NewStorage(config models.Config) (Storage, error) {
 	switch config.StorageType {
 	case "file":
-		return NewFileStorage(config.StoragePath), nil
+		return NewValidatingStorage(NewFileStorage(config.StoragePath), config.LicensePolicy), nil
 	case "psql":
 		return nil, fmt.Errorf("Not implemented yet")
 	}
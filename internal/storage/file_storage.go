@@ -3,11 +3,36 @@ package storage
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/bear-belly/mcp-registry/internal/errors"
 	"github.com/bear-belly/mcp-registry/internal/models"
 )
 
+// errStopIteration ends an EachServer walk early once the server sought has
+// been found
+var errStopIteration = stderrors.New("stop iteration")
+
+// Subdirectories of the storage path holding non-server records. Server
+// records live directly in the storage path.
+const (
+	tagsDir        = "tags"
+	categoriesDir  = "categories"
+	collectionsDir = "collections"
+	profilesDir    = "profiles"
+	webhooksDir    = "webhooks"
+	deliveriesDir  = "deliveries"
+	versionsDir    = "versions"
+	idempotencyDir = "idempotency"
+)
+
 type FileStorage struct {
 	StoragePath string
 }
@@ -17,44 +42,464 @@ func NewFileStorage(path string) *FileStorage {
 		StoragePath: path,
 	}
 
-	// TODO: initialise storage path
+	for _, dir := range []string{tagsDir, categoriesDir, collectionsDir, profilesDir, webhooksDir, deliveriesDir, versionsDir, idempotencyDir} {
+		// a missing directory is reported by the first operation that needs it
+		os.MkdirAll(filepath.Join(path, dir), 0755)
+	}
 
 	return fs
 }
 
 func (fs *FileStorage) ListServers(ctx context.Context) ([]models.Server, error) {
-	entries, err := os.ReadDir(fs.StoragePath)
+	servers := []models.Server{}
+	err := fs.EachServer(ctx, func(server models.Server) error {
+		servers = append(servers, server)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var servers []models.Server
+	return servers, nil
+}
+
+func (fs *FileStorage) EachServer(ctx context.Context, fn func(models.Server) error) error {
+	entries, err := os.ReadDir(fs.StoragePath)
+	if err != nil {
+		return err
+	}
 
 	for _, fsEntry := range entries {
-		filename := fs.StoragePath + "/" + fsEntry.Name()
-		content, err := os.ReadFile(filename)
+		if !isJSONFile(fsEntry) {
+			continue
+		}
+
+		server, err := fs.readServer(fsEntry.Name())
 		if err != nil {
+			return err
+		}
+		if err := fn(server); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (fs *FileStorage) GetServer(ctx context.Context, id string) (models.Server, error) {
+	if !idPattern.MatchString(id) {
+		return models.Server{}, errors.NewNotFoundError("Server")
+	}
+
+	server, err := fs.readServer(id + ".json")
+	if os.IsNotExist(err) {
+		return models.Server{}, errors.NewNotFoundError("Server")
+	}
+	return server, err
+}
+
+func (fs *FileStorage) GetServerBySlug(ctx context.Context, slug string) (models.Server, error) {
+	// A current slug always wins over a previous slug of another server
+	var current, previous *models.Server
+	err := fs.EachServer(ctx, func(server models.Server) error {
+		if server.Slug == slug {
+			current = &server
+			return errStopIteration
+		}
+		if previous == nil && slices.Contains(server.PreviousSlugs, slug) {
+			previous = &server
+		}
+		return nil
+	})
+	if err != nil && err != errStopIteration {
+		return models.Server{}, err
+	}
+
+	switch {
+	case current != nil:
+		return *current, nil
+	case previous != nil:
+		return *previous, nil
+	}
+	return models.Server{}, errors.NewNotFoundError("Server")
+}
+
+func (fs *FileStorage) CreateServer(ctx context.Context, server models.Server) (models.Server, error) {
+	server = prepareNew(server)
+	if !idPattern.MatchString(server.ID) {
+		return models.Server{}, errors.NewBadRequestError("Server ID may only contain letters, digits, '-' and '_'")
+	}
+
+	if _, err := fs.GetServer(ctx, server.ID); err == nil {
+		return models.Server{}, errors.NewConflictError(fmt.Sprintf("Server with ID %q already exists", server.ID))
+	}
+	if err := fs.checkSlugAvailable(ctx, server); err != nil {
+		return models.Server{}, err
+	}
+
+	if err := fs.writeServer(ctx, server); err != nil {
+		return models.Server{}, err
+	}
+	return server, nil
+}
+
+func (fs *FileStorage) UpdateServer(ctx context.Context, server models.Server) (models.Server, error) {
+	existing, err := fs.GetServer(ctx, server.ID)
+	if err != nil {
+		return models.Server{}, err
+	}
+
+	server = prepareUpdate(existing, server)
+	if err := fs.checkSlugAvailable(ctx, server); err != nil {
+		return models.Server{}, err
+	}
+
+	if err := fs.writeServer(ctx, server); err != nil {
+		return models.Server{}, err
+	}
+	return server, nil
+}
+
+func (fs *FileStorage) DeleteServer(ctx context.Context, id string) error {
+	if !idPattern.MatchString(id) {
+		return errors.NewNotFoundError("Server")
+	}
+	if err := removeJSONFile(fs.serverFile(id), "Server"); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(fs.StoragePath, versionsDir, id))
+}
+
+func (fs *FileStorage) ListServerVersions(ctx context.Context, id string) ([]models.Server, error) {
+	if _, err := fs.GetServer(ctx, id); err != nil {
+		return nil, err
+	}
+
+	versions, err := readJSONDir[models.Server](filepath.Join(fs.StoragePath, versionsDir, id))
+	if os.IsNotExist(err) {
+		return []models.Server{}, nil
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].UpdatedAt.After(versions[j].UpdatedAt) })
+	return versions, err
+}
+
+// writeServer saves the record and, when it carries a version, a snapshot of
+// it as the latest state of that version. A dry run stops short of writing.
+func (fs *FileStorage) writeServer(ctx context.Context, server models.Server) error {
+	if server.Version != "" && !versionPattern.MatchString(server.Version) {
+		return errors.NewBadRequestError("Server version may only contain letters, digits, '.', '+', '-' and '_'")
+	}
+	if IsDryRun(ctx) {
+		return nil
+	}
+	if err := writeJSONFile(fs.serverFile(server.ID), server); err != nil {
+		return err
+	}
+	if server.Version == "" {
+		return nil
+	}
+
+	dir := filepath.Join(fs.StoragePath, versionsDir, server.ID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return writeJSONFile(filepath.Join(dir, server.Version+".json"), server)
+}
+
+// checkSlugAvailable makes sure no other server currently uses the slug
+func (fs *FileStorage) checkSlugAvailable(ctx context.Context, server models.Server) error {
+	return fs.EachServer(ctx, func(other models.Server) error {
+		if other.ID != server.ID && other.Slug == server.Slug {
+			return errors.NewConflictError(fmt.Sprintf("Slug %q is already used by server %q", server.Slug, other.Name))
+		}
+		return nil
+	})
+}
+
+func (fs *FileStorage) serverFile(id string) string {
+	return filepath.Join(fs.StoragePath, id+".json")
+}
+
+// readServer loads a server record. Hand-written records may leave out the
+// ID and slug, in which case the file name and the name stand in for them.
+func (fs *FileStorage) readServer(filename string) (models.Server, error) {
+	var server models.Server
+	if err := readJSONFile(filepath.Join(fs.StoragePath, filename), &server); err != nil {
+		return models.Server{}, err
+	}
+
+	if server.ID == "" {
+		server.ID = strings.TrimSuffix(filename, ".json")
+	}
+	if server.Slug == "" {
+		server.Slug = models.Slugify(server.Name)
+	}
+	if server.UpdatedAt.IsZero() {
+		server.UpdatedAt = server.CreatedAt
+	}
+
+	return server, nil
+}
+
+func (fs *FileStorage) ListTags(ctx context.Context) ([]models.Tag, error) {
+	tags, err := readJSONDir[models.Tag](filepath.Join(fs.StoragePath, tagsDir))
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+	return tags, err
+}
+
+func (fs *FileStorage) SaveTag(ctx context.Context, tag models.Tag) error {
+	if !validSlug(tag.Slug) {
+		return errors.NewBadRequestError("Invalid tag slug")
+	}
+	return writeJSONFile(filepath.Join(fs.StoragePath, tagsDir, tag.Slug+".json"), tag)
+}
+
+func (fs *FileStorage) DeleteTag(ctx context.Context, slug string) error {
+	if !validSlug(slug) {
+		return errors.NewNotFoundError("Tag")
+	}
+	return removeJSONFile(filepath.Join(fs.StoragePath, tagsDir, slug+".json"), "Tag")
+}
+
+func (fs *FileStorage) ListCategories(ctx context.Context) ([]models.Category, error) {
+	categories, err := readJSONDir[models.Category](filepath.Join(fs.StoragePath, categoriesDir))
+	sort.Slice(categories, func(i, j int) bool { return categories[i].Name < categories[j].Name })
+	return categories, err
+}
+
+func (fs *FileStorage) SaveCategory(ctx context.Context, category models.Category) error {
+	if !validSlug(category.Slug) {
+		return errors.NewBadRequestError("Invalid category slug")
+	}
+	return writeJSONFile(filepath.Join(fs.StoragePath, categoriesDir, category.Slug+".json"), category)
+}
+
+func (fs *FileStorage) ListCollections(ctx context.Context) ([]models.Collection, error) {
+	collections, err := readJSONDir[models.Collection](filepath.Join(fs.StoragePath, collectionsDir))
+	sort.Slice(collections, func(i, j int) bool { return collections[i].Name < collections[j].Name })
+	return collections, err
+}
+
+func (fs *FileStorage) SaveCollection(ctx context.Context, collection models.Collection) error {
+	if !validSlug(collection.Slug) {
+		return errors.NewBadRequestError("Invalid collection slug")
+	}
+	return writeJSONFile(filepath.Join(fs.StoragePath, collectionsDir, collection.Slug+".json"), collection)
+}
+
+func (fs *FileStorage) DeleteCollection(ctx context.Context, slug string) error {
+	if !validSlug(slug) {
+		return errors.NewNotFoundError("Collection")
+	}
+	return removeJSONFile(filepath.Join(fs.StoragePath, collectionsDir, slug+".json"), "Collection")
+}
+
+func (fs *FileStorage) ListProfiles(ctx context.Context) ([]models.Profile, error) {
+	profiles, err := readJSONDir[models.Profile](filepath.Join(fs.StoragePath, profilesDir))
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles, err
+}
+
+func (fs *FileStorage) SaveProfile(ctx context.Context, profile models.Profile) error {
+	if !validSlug(profile.Slug) {
+		return errors.NewBadRequestError("Invalid profile slug")
+	}
+	return writeJSONFile(filepath.Join(fs.StoragePath, profilesDir, profile.Slug+".json"), profile)
+}
+
+func (fs *FileStorage) DeleteProfile(ctx context.Context, slug string) error {
+	if !validSlug(slug) {
+		return errors.NewNotFoundError("Profile")
+	}
+	return removeJSONFile(filepath.Join(fs.StoragePath, profilesDir, slug+".json"), "Profile")
+}
+
+func (fs *FileStorage) ListWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	webhooks, err := readJSONDir[models.Webhook](filepath.Join(fs.StoragePath, webhooksDir))
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt) })
+	return webhooks, err
+}
+
+func (fs *FileStorage) GetWebhook(ctx context.Context, id string) (models.Webhook, error) {
+	var webhook models.Webhook
+	if !idPattern.MatchString(id) {
+		return webhook, errors.NewNotFoundError("Webhook")
+	}
+
+	err := readJSONFile(filepath.Join(fs.StoragePath, webhooksDir, id+".json"), &webhook)
+	if os.IsNotExist(err) {
+		return webhook, errors.NewNotFoundError("Webhook")
+	}
+	return webhook, err
+}
+
+func (fs *FileStorage) SaveWebhook(ctx context.Context, webhook models.Webhook) error {
+	if !idPattern.MatchString(webhook.ID) {
+		return errors.NewBadRequestError("Invalid webhook ID")
+	}
+	return writeJSONFile(filepath.Join(fs.StoragePath, webhooksDir, webhook.ID+".json"), webhook)
+}
+
+func (fs *FileStorage) DeleteWebhook(ctx context.Context, id string) error {
+	if !idPattern.MatchString(id) {
+		return errors.NewNotFoundError("Webhook")
+	}
+	if err := removeJSONFile(filepath.Join(fs.StoragePath, webhooksDir, id+".json"), "Webhook"); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(fs.StoragePath, deliveriesDir, id))
+}
+
+func (fs *FileStorage) ListDeliveries(ctx context.Context, webhookID string) ([]models.Delivery, error) {
+	if !idPattern.MatchString(webhookID) {
+		return nil, errors.NewNotFoundError("Webhook")
+	}
+
+	deliveries, err := readJSONDir[models.Delivery](filepath.Join(fs.StoragePath, deliveriesDir, webhookID))
+	if os.IsNotExist(err) {
+		return []models.Delivery{}, nil
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt) })
+	return deliveries, err
+}
+
+func (fs *FileStorage) GetDelivery(ctx context.Context, webhookID, id string) (models.Delivery, error) {
+	var delivery models.Delivery
+	if !idPattern.MatchString(webhookID) || !idPattern.MatchString(id) {
+		return delivery, errors.NewNotFoundError("Delivery")
+	}
+
+	err := readJSONFile(filepath.Join(fs.StoragePath, deliveriesDir, webhookID, id+".json"), &delivery)
+	if os.IsNotExist(err) {
+		return delivery, errors.NewNotFoundError("Delivery")
+	}
+	return delivery, err
+}
+
+func (fs *FileStorage) SaveDelivery(ctx context.Context, delivery models.Delivery) error {
+	if !idPattern.MatchString(delivery.WebhookID) || !idPattern.MatchString(delivery.ID) {
+		return errors.NewBadRequestError("Invalid delivery ID")
+	}
+
+	dir := filepath.Join(fs.StoragePath, deliveriesDir, delivery.WebhookID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return writeJSONFile(filepath.Join(dir, delivery.ID+".json"), delivery)
+}
+
+func (fs *FileStorage) GetIdempotencyRecord(ctx context.Context, id string) (models.IdempotencyRecord, error) {
+	var record models.IdempotencyRecord
+	if !idPattern.MatchString(id) {
+		return record, errors.NewNotFoundError("Idempotency record")
+	}
+
+	err := readJSONFile(filepath.Join(fs.StoragePath, idempotencyDir, id+".json"), &record)
+	if os.IsNotExist(err) {
+		return record, errors.NewNotFoundError("Idempotency record")
+	}
+	return record, err
+}
+
+func (fs *FileStorage) SaveIdempotencyRecord(ctx context.Context, record models.IdempotencyRecord) error {
+	if !idPattern.MatchString(record.ID) {
+		return errors.NewBadRequestError("Invalid idempotency record ID")
+	}
+	return writeJSONFile(filepath.Join(fs.StoragePath, idempotencyDir, record.ID+".json"), record)
+}
+
+func (fs *FileStorage) DeleteExpiredIdempotencyRecords(ctx context.Context, now time.Time) (int, error) {
+	dir := filepath.Join(fs.StoragePath, idempotencyDir)
+	records, err := readJSONDir[models.IdempotencyRecord](dir)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, record := range records {
+		if !record.ExpiresAt.Before(now) || !idPattern.MatchString(record.ID) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, record.ID+".json")); err != nil && !os.IsNotExist(err) {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+func isJSONFile(entry os.DirEntry) bool {
+	return !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json")
+}
+
+// readJSONDir decodes every JSON file directly inside dir
+func readJSONDir[T any](dir string) ([]T, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []T{}
+
+	for _, fsEntry := range entries {
+		if !isJSONFile(fsEntry) {
+			continue
+		}
+
+		var record T
+		if err := readJSONFile(filepath.Join(dir, fsEntry.Name()), &record); err != nil {
 			return nil, err
 		}
+		records = append(records, record)
+	}
 
-		var server models.Server
-		json.Unmarshal(content, &server)
-		servers = append(servers, server)
+	return records, nil
+}
+
+func readJSONFile(filename string, v any) error {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return err
 	}
 
-	return servers, nil
+	return json.Unmarshal(content, v)
 }
 
-func (fs *FileStorage) CreateServer(ctx context.Context, server models.Server) error {
-	data, err := json.Marshal(server)
+// writeJSONFile writes v to a temporary file first and renames it into place,
+// so readers never see a half-written record. Each write gets its own
+// temporary file, so that concurrent writes to a record cannot interleave;
+// the last rename wins.
+func writeJSONFile(filename string, v any) error {
+	data, err := json.MarshalIndent(v, "", "    ")
 	if err != nil {
 		return err
 	}
 
-	err = os.WriteFile(server.Name+".json", data, 0644)
+	tmp, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*.tmp")
 	if err != nil {
 		return err
 	}
+	defer os.Remove(tmp.Name())
 
-	return nil
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filename)
+}
+
+func removeJSONFile(filename string, resource string) error {
+	err := os.Remove(filename)
+	if os.IsNotExist(err) {
+		return errors.NewNotFoundError(resource)
+	}
+	return err
 }
@@ -0,0 +1,219 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func TestFileStorage_CreateAssignsKeys(t *testing.T) {
+	fs := NewFileStorage(t.TempDir())
+	ctx := context.Background()
+
+	created, err := fs.CreateServer(ctx, models.Server{Name: "GitHub Enterprise"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created.ID == "" {
+		t.Error("expected an ID to be assigned")
+	}
+	if created.Slug != "github-enterprise" {
+		t.Errorf("expected slug github-enterprise, got %q", created.Slug)
+	}
+	if created.CreatedAt.IsZero() {
+		t.Error("expected CreatedAt to be set")
+	}
+
+	if _, err := os.Stat(filepath.Join(fs.StoragePath, created.ID+".json")); err != nil {
+		t.Errorf("expected record to be keyed by ID: %v", err)
+	}
+}
+
+func TestFileStorage_CreateRejectsDuplicateSlug(t *testing.T) {
+	fs := NewFileStorage(t.TempDir())
+	ctx := context.Background()
+
+	if _, err := fs.CreateServer(ctx, models.Server{Name: "GitHub"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	_, err := fs.CreateServer(ctx, models.Server{Name: "github"})
+	if appErr, ok := err.(*errors.AppError); !ok || appErr.Type != errors.ErrorTypeConflict {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+}
+
+func TestFileStorage_RenameKeepsIDAndPreviousSlug(t *testing.T) {
+	fs := NewFileStorage(t.TempDir())
+	ctx := context.Background()
+
+	created, err := fs.CreateServer(ctx, models.Server{Name: "GitHub"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	created.Name = "GitHub Enterprise"
+	updated, err := fs.UpdateServer(ctx, created)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated.ID != created.ID {
+		t.Errorf("expected ID %q to be kept, got %q", created.ID, updated.ID)
+	}
+
+	servers, err := fs.ListServers(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(servers) != 1 {
+		t.Fatalf("expected rename to keep a single record, got %d", len(servers))
+	}
+
+	found, err := fs.GetServerBySlug(ctx, "github")
+	if err != nil {
+		t.Fatalf("expected old slug to resolve, got %v", err)
+	}
+	if found.Slug != "github-enterprise" {
+		t.Errorf("expected current slug github-enterprise, got %q", found.Slug)
+	}
+}
+
+func TestFileStorage_GetServerRejectsPathTraversal(t *testing.T) {
+	fs := NewFileStorage(t.TempDir())
+
+	_, err := fs.GetServer(context.Background(), "../secrets")
+	if appErr, ok := err.(*errors.AppError); !ok || appErr.Type != errors.ErrorTypeNotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+// TestFileStorage_RejectsUnsafeSlugs checks that slug-keyed records cannot
+// name a file outside their directory, such as a server record
+func TestFileStorage_RejectsUnsafeSlugs(t *testing.T) {
+	fs := NewFileStorage(t.TempDir())
+	ctx := context.Background()
+
+	server, err := fs.CreateServer(ctx, models.Server{Name: "GitHub"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	escape := "../" + server.ID
+
+	writes := map[string]func() error{
+		"SaveTag":          func() error { return fs.SaveTag(ctx, models.Tag{Slug: escape}) },
+		"DeleteTag":        func() error { return fs.DeleteTag(ctx, escape) },
+		"SaveCategory":     func() error { return fs.SaveCategory(ctx, models.Category{Slug: escape}) },
+		"SaveCollection":   func() error { return fs.SaveCollection(ctx, models.Collection{Slug: escape}) },
+		"DeleteCollection": func() error { return fs.DeleteCollection(ctx, escape) },
+		"SaveProfile":      func() error { return fs.SaveProfile(ctx, models.Profile{Slug: escape}) },
+		"DeleteProfile":    func() error { return fs.DeleteProfile(ctx, escape) },
+	}
+	for name, write := range writes {
+		if err := write(); err == nil {
+			t.Errorf("expected %s to reject slug %q", name, escape)
+		}
+	}
+
+	if _, err := fs.GetServer(ctx, server.ID); err != nil {
+		t.Errorf("expected the server record to be untouched, got %v", err)
+	}
+}
+
+func TestFileStorage_ConcurrentWritesToOneRecord(t *testing.T) {
+	fs := NewFileStorage(t.TempDir())
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- fs.SaveTag(ctx, models.Tag{Slug: "git", Name: strings.Repeat("Git", i+1)})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("expected every write to succeed, got %v", err)
+		}
+	}
+
+	entries, _ := os.ReadDir(filepath.Join(fs.StoragePath, tagsDir))
+	if len(entries) != 1 || entries[0].Name() != "git.json" {
+		t.Errorf("expected a single record and no temporary files, got %v", entries)
+	}
+	if tags, err := fs.ListTags(ctx); err != nil || len(tags) != 1 {
+		t.Errorf("expected one readable tag, got %v (%v)", tags, err)
+	}
+}
+
+func TestFileStorage_KeepsLastRecordOfEachVersion(t *testing.T) {
+	fs := NewFileStorage(t.TempDir())
+	ctx := context.Background()
+
+	created, err := fs.CreateServer(ctx, models.Server{Name: "GitHub", Version: "1.0.0", Description: "first"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	created.Description = "second"
+	if created, err = fs.UpdateServer(ctx, created); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	created.Version = "1.1.0"
+	if _, err := fs.UpdateServer(ctx, created); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	versions, err := fs.ListServerVersions(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != "1.1.0" || versions[1].Version != "1.0.0" {
+		t.Fatalf("expected versions 1.1.0 and 1.0.0, got %+v", versions)
+	}
+	if versions[1].Description != "second" {
+		t.Errorf("expected the last record of 1.0.0, got %q", versions[1].Description)
+	}
+
+	if err := fs.DeleteServer(ctx, created.ID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if versions, _ := fs.ListServerVersions(ctx, created.ID); len(versions) != 0 {
+		t.Errorf("expected the history to be deleted with the server, got %d versions", len(versions))
+	}
+}
+
+func TestFileStorage_DeletesExpiredIdempotencyRecords(t *testing.T) {
+	fs := NewFileStorage(t.TempDir())
+	ctx := context.Background()
+	now := time.Now()
+
+	for id, expires := range map[string]time.Time{"expired": now.Add(-time.Minute), "live": now.Add(time.Minute)} {
+		if err := fs.SaveIdempotencyRecord(ctx, models.IdempotencyRecord{ID: id, StatusCode: 201, ExpiresAt: expires}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	deleted, err := fs.DeleteExpiredIdempotencyRecords(ctx, now)
+	if err != nil || deleted != 1 {
+		t.Fatalf("expected one record deleted, got %d (%v)", deleted, err)
+	}
+	if _, err := fs.GetIdempotencyRecord(ctx, "expired"); err == nil {
+		t.Errorf("expected the expired record to be gone, got %v", err)
+	}
+	if record, err := fs.GetIdempotencyRecord(ctx, "live"); err != nil || record.StatusCode != 201 {
+		t.Errorf("expected the live record to be kept, got %+v (%v)", record, err)
+	}
+}
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// idPattern guards against IDs that could escape the storage location
+var idPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// versionPattern guards the version snapshot file names the same way
+var versionPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.+_-]*$`)
+
+// validSlug guards the slugs that name tag, category, collection and
+// profile files the way idPattern guards IDs
+func validSlug(slug string) bool {
+	return slug != "" && models.Slugify(slug) == slug
+}
+
+// prepareNew assigns the keys of a server that is about to be created
+func prepareNew(server models.Server) models.Server {
+	if server.ID == "" {
+		server.ID = models.NewID()
+	}
+	if server.CreatedAt.IsZero() {
+		server.CreatedAt = time.Now().UTC()
+	}
+	server.UpdatedAt = time.Now().UTC()
+
+	server.Slug = models.Slugify(server.Name)
+	server.PreviousSlugs = nil
+	server.RiskAssessment = nil
+	server.LicenseDecision = ""
+
+	return server
+}
+
+// prepareUpdate carries the immutable fields of the stored record over to
+// its replacement. When a rename changes the slug, the old slug is kept so
+// that existing links can be redirected.
+func prepareUpdate(existing, server models.Server) models.Server {
+	server.ID = existing.ID
+	server.CreatedAt = existing.CreatedAt
+	server.UpdatedAt = time.Now().UTC()
+	server.Slug = models.Slugify(server.Name)
+	server.PreviousSlugs = slices.Clone(existing.PreviousSlugs)
+	server.RiskAssessment = nil
+	server.LicenseDecision = ""
+
+	if existing.Slug != server.Slug && !slices.Contains(server.PreviousSlugs, existing.Slug) {
+		server.PreviousSlugs = append(server.PreviousSlugs, existing.Slug)
+	}
+	server.PreviousSlugs = slices.DeleteFunc(server.PreviousSlugs, func(slug string) bool {
+		return slug == server.Slug
+	})
+	if len(server.PreviousSlugs) == 0 {
+		server.PreviousSlugs = nil
+	}
+
+	return server
+}
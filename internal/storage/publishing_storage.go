@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/events"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// PublishingStorage publishes an event for every server write that the
+// underlying storage accepts, and records when the catalog last changed:
+// its servers, tags, categories, collections or profiles. Dry runs store
+// nothing, so they publish and record nothing either.
+type PublishingStorage struct {
+	Storage
+	broker *events.Broker
+
+	// modified is when a catalog write last succeeded, in Unix nanoseconds,
+	// and zero until the first one
+	modified atomic.Int64
+}
+
+func NewPublishingStorage(storage Storage, broker *events.Broker) *PublishingStorage {
+	return &PublishingStorage{Storage: storage, broker: broker}
+}
+
+// ModifiedAt is when the catalog last changed through this storage, or the
+// zero time if it has not changed since the storage was created
+func (ps *PublishingStorage) ModifiedAt() time.Time {
+	if modified := ps.modified.Load(); modified != 0 {
+		return time.Unix(0, modified)
+	}
+	return time.Time{}
+}
+
+// touch records that the catalog changed just now
+func (ps *PublishingStorage) touch() {
+	ps.modified.Store(time.Now().UnixNano())
+}
+
+// changed records a catalog write unless the underlying storage refused it
+func (ps *PublishingStorage) changed(ctx context.Context, err error) error {
+	if err == nil && !IsDryRun(ctx) {
+		ps.touch()
+	}
+	return err
+}
+
+func (ps *PublishingStorage) CreateServer(ctx context.Context, server models.Server) (models.Server, error) {
+	created, err := ps.Storage.CreateServer(ctx, server)
+	if err == nil && !IsDryRun(ctx) {
+		ps.touch()
+		ps.broker.Publish(events.ServerCreated, serverChange(created))
+	}
+	return created, err
+}
+
+// UpdateServer publishes server.status_changed when the write changes the
+// status, and server.updated otherwise
+func (ps *PublishingStorage) UpdateServer(ctx context.Context, server models.Server) (models.Server, error) {
+	existing, err := ps.Storage.GetServer(ctx, server.ID)
+	if err != nil {
+		return models.Server{}, err
+	}
+
+	updated, err := ps.Storage.UpdateServer(ctx, server)
+	if err != nil || IsDryRun(ctx) {
+		return updated, err
+	}
+	ps.touch()
+
+	change := serverChange(updated)
+	if existing.Status != updated.Status {
+		change.PreviousStatus = existing.Status
+		ps.broker.Publish(events.ServerStatusChanged, change)
+	} else {
+		ps.broker.Publish(events.ServerUpdated, change)
+	}
+	return updated, nil
+}
+
+func (ps *PublishingStorage) DeleteServer(ctx context.Context, id string) error {
+	existing, err := ps.Storage.GetServer(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := ps.Storage.DeleteServer(ctx, id); err != nil {
+		return err
+	}
+	ps.touch()
+
+	ps.broker.Publish(events.ServerDeleted, events.ServerChange{ID: existing.ID, Slug: existing.Slug, PreviousStatus: existing.Status})
+	return nil
+}
+
+func (ps *PublishingStorage) SaveTag(ctx context.Context, tag models.Tag) error {
+	return ps.changed(ctx, ps.Storage.SaveTag(ctx, tag))
+}
+
+func (ps *PublishingStorage) DeleteTag(ctx context.Context, slug string) error {
+	return ps.changed(ctx, ps.Storage.DeleteTag(ctx, slug))
+}
+
+func (ps *PublishingStorage) SaveCategory(ctx context.Context, category models.Category) error {
+	return ps.changed(ctx, ps.Storage.SaveCategory(ctx, category))
+}
+
+func (ps *PublishingStorage) SaveCollection(ctx context.Context, collection models.Collection) error {
+	return ps.changed(ctx, ps.Storage.SaveCollection(ctx, collection))
+}
+
+func (ps *PublishingStorage) DeleteCollection(ctx context.Context, slug string) error {
+	return ps.changed(ctx, ps.Storage.DeleteCollection(ctx, slug))
+}
+
+func (ps *PublishingStorage) SaveProfile(ctx context.Context, profile models.Profile) error {
+	return ps.changed(ctx, ps.Storage.SaveProfile(ctx, profile))
+}
+
+func (ps *PublishingStorage) DeleteProfile(ctx context.Context, slug string) error {
+	return ps.changed(ctx, ps.Storage.DeleteProfile(ctx, slug))
+}
+
+func serverChange(server models.Server) events.ServerChange {
+	return events.ServerChange{ID: server.ID, Slug: server.Slug, Status: server.Status, Server: &server}
+}
@@ -2,11 +2,84 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/bear-belly/mcp-registry/internal/models"
 )
 
 type Storage interface {
+	ServerStorage
+	TaxonomyStorage
+	ProfileStorage
+	WebhookStorage
+	IdempotencyStorage
+}
+
+// ServerStorage persists server records. Records are keyed by their
+// immutable ID; CreateServer assigns the ID and both writes derive the slug
+// from the name.
+type ServerStorage interface {
 	ListServers(ctx context.Context) ([]models.Server, error)
-	CreateServer(ctx context.Context, server models.Server) error
+	// EachServer calls fn with every server in turn without holding them all
+	// in memory. An error from fn stops the iteration and is returned.
+	EachServer(ctx context.Context, fn func(models.Server) error) error
+	GetServer(ctx context.Context, id string) (models.Server, error)
+	// GetServerBySlug also matches previous slugs, so callers should compare
+	// the returned server's Slug to decide whether to redirect
+	GetServerBySlug(ctx context.Context, slug string) (models.Server, error)
+	CreateServer(ctx context.Context, server models.Server) (models.Server, error)
+	UpdateServer(ctx context.Context, server models.Server) (models.Server, error)
+	DeleteServer(ctx context.Context, id string) error
+	// ListServerVersions returns the last record written for each version
+	// of a server, newest first. Writes without a version keep no history.
+	ListServerVersions(ctx context.Context, id string) ([]models.Server, error)
+}
+
+// TaxonomyStorage persists the managed tag and category vocabularies and the
+// curated collections built on top of them
+type TaxonomyStorage interface {
+	ListTags(ctx context.Context) ([]models.Tag, error)
+	SaveTag(ctx context.Context, tag models.Tag) error
+	DeleteTag(ctx context.Context, slug string) error
+
+	ListCategories(ctx context.Context) ([]models.Category, error)
+	SaveCategory(ctx context.Context, category models.Category) error
+
+	ListCollections(ctx context.Context) ([]models.Collection, error)
+	SaveCollection(ctx context.Context, collection models.Collection) error
+	DeleteCollection(ctx context.Context, slug string) error
+}
+
+// ProfileStorage persists the server bundles admins put together for
+// installing several servers at once
+type ProfileStorage interface {
+	ListProfiles(ctx context.Context) ([]models.Profile, error)
+	SaveProfile(ctx context.Context, profile models.Profile) error
+	DeleteProfile(ctx context.Context, slug string) error
+}
+
+// WebhookStorage persists webhook subscriptions and the log of deliveries
+// made to each of them
+type WebhookStorage interface {
+	ListWebhooks(ctx context.Context) ([]models.Webhook, error)
+	GetWebhook(ctx context.Context, id string) (models.Webhook, error)
+	SaveWebhook(ctx context.Context, webhook models.Webhook) error
+	// DeleteWebhook also removes the webhook's delivery log
+	DeleteWebhook(ctx context.Context, id string) error
+
+	// ListDeliveries returns the deliveries of a webhook, newest first
+	ListDeliveries(ctx context.Context, webhookID string) ([]models.Delivery, error)
+	GetDelivery(ctx context.Context, webhookID, id string) (models.Delivery, error)
+	SaveDelivery(ctx context.Context, delivery models.Delivery) error
+}
+
+// IdempotencyStorage persists the responses to requests made with an
+// Idempotency-Key, so that retries can be answered without repeating them
+type IdempotencyStorage interface {
+	// GetIdempotencyRecord returns a record whether or not it has expired
+	GetIdempotencyRecord(ctx context.Context, id string) (models.IdempotencyRecord, error)
+	SaveIdempotencyRecord(ctx context.Context, record models.IdempotencyRecord) error
+	// DeleteExpiredIdempotencyRecords removes the records that expired before
+	// now and returns how many there were
+	DeleteExpiredIdempotencyRecords(ctx context.Context, now time.Time) (int, error)
 }
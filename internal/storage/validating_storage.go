@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/validation"
+)
+
+// ValidatingStorage checks every server against the validation rules before
+// handing it to the underlying storage, so that no write path can persist an
+// invalid record. Servers can only be stored as approved once their license
+// satisfies the license policy.
+type ValidatingStorage struct {
+	Storage
+	licensePolicy models.LicensePolicy
+}
+
+func NewValidatingStorage(storage Storage, licensePolicy models.LicensePolicy) *ValidatingStorage {
+	return &ValidatingStorage{Storage: storage, licensePolicy: licensePolicy}
+}
+
+func (vs *ValidatingStorage) CreateServer(ctx context.Context, server models.Server) (models.Server, error) {
+	if err := vs.validate(ctx, server); err != nil {
+		return models.Server{}, err
+	}
+
+	return vs.Storage.CreateServer(ctx, server)
+}
+
+func (vs *ValidatingStorage) UpdateServer(ctx context.Context, server models.Server) (models.Server, error) {
+	if err := vs.validate(ctx, server); err != nil {
+		return models.Server{}, err
+	}
+
+	return vs.Storage.UpdateServer(ctx, server)
+}
+
+// DeleteServer refuses to delete a server that other servers, collections or
+// profiles still refer to, so that no reference is left dangling
+func (vs *ValidatingStorage) DeleteServer(ctx context.Context, id string) error {
+	servers, err := vs.ListServers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, server := range servers {
+		for _, relationship := range server.Relationships {
+			if relationship.Target == id && server.ID != id {
+				return errors.NewConflictError(fmt.Sprintf("Server is referenced by a %s relationship of %q", relationship.Type, server.Name))
+			}
+		}
+	}
+
+	collections, err := vs.ListCollections(ctx)
+	if err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		if slices.Contains(collection.Servers, id) {
+			return errors.NewConflictError(fmt.Sprintf("Server is part of the %q collection", collection.Name))
+		}
+	}
+
+	profiles, err := vs.ListProfiles(ctx)
+	if err != nil {
+		return err
+	}
+	for _, profile := range profiles {
+		if slices.Contains(profile.Servers, id) {
+			return errors.NewConflictError(fmt.Sprintf("Server is part of the %q profile", profile.Name))
+		}
+	}
+
+	return vs.Storage.DeleteServer(ctx, id)
+}
+
+func (vs *ValidatingStorage) validate(ctx context.Context, server models.Server) error {
+	vocabulary, err := vs.vocabulary(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := validation.ValidateServer(server, vocabulary); err != nil {
+		return err
+	}
+
+	if server.Status == models.StatusApproved {
+		c := &validation.Collector{}
+		validation.CheckLicensePolicy(server, vs.licensePolicy, c)
+		if err := c.Err(); err != nil {
+			return err
+		}
+	}
+
+	return vs.checkReferences(ctx, server)
+}
+
+// checkReferences enforces referential integrity: every relationship must
+// point at a server that exists
+func (vs *ValidatingStorage) checkReferences(ctx context.Context, server models.Server) error {
+	c := &validation.Collector{}
+
+	for i, relationship := range server.Relationships {
+		_, err := vs.GetServer(ctx, relationship.Target)
+		if appErr, ok := err.(*errors.AppError); ok && appErr.Type == errors.ErrorTypeNotFound {
+			c.Add(fmt.Sprintf("relationships[%d].target", i), validation.RuleReference,
+				"relationship target %q does not exist", relationship.Target)
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return c.Err()
+}
+
+// vocabulary collects the tag and category slugs servers may reference
+func (vs *ValidatingStorage) vocabulary(ctx context.Context) (*validation.Vocabulary, error) {
+	tags, err := vs.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := vs.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vocabulary := &validation.Vocabulary{}
+	for _, tag := range tags {
+		vocabulary.Tags = append(vocabulary.Tags, tag.Slug)
+	}
+	for _, category := range categories {
+		vocabulary.Categories = append(vocabulary.Categories, category.Slug)
+	}
+
+	return vocabulary, nil
+}
@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/license"
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/validation"
+)
+
+func validServer(name string) models.Server {
+	return models.Server{
+		Name:        name,
+		Description: "A test server",
+		Status:      models.StatusNew,
+		Transport:   models.TransportSSE,
+		URL:         "https://example.com/sse",
+	}
+}
+
+func TestValidatingStorage_RelationshipTargetMustExist(t *testing.T) {
+	vs := NewValidatingStorage(NewFileStorage(t.TempDir()), license.DefaultPolicy())
+	ctx := context.Background()
+
+	target, err := vs.CreateServer(ctx, validServer("GitHub"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	server := validServer("IDP")
+	server.Relationships = []models.Relationship{{Type: models.RelationDependsOn, Target: target.ID}}
+	if _, err := vs.CreateServer(ctx, server); err != nil {
+		t.Fatalf("expected relationship to an existing server to be accepted, got %v", err)
+	}
+
+	server = validServer("Jira")
+	server.Relationships = []models.Relationship{{Type: models.RelationDependsOn, Target: "missing"}}
+	_, err = vs.CreateServer(ctx, server)
+	appErr, ok := err.(*errors.AppError)
+	if !ok || appErr.Type != errors.ErrorTypeValidation {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	details, _ := appErr.Details.([]validation.FieldError)
+	if len(details) != 1 || details[0].Field != "relationships[0].target" || details[0].Rule != validation.RuleReference {
+		t.Errorf("expected a reference error on relationships[0].target, got %+v", appErr.Details)
+	}
+}
+
+func TestValidatingStorage_ApprovalEnforcesLicensePolicy(t *testing.T) {
+	vs := NewValidatingStorage(NewFileStorage(t.TempDir()), license.DefaultPolicy())
+	ctx := context.Background()
+
+	server := validServer("GitHub")
+	server.Status = models.StatusApproved
+	server.License = "GPL-3.0-only"
+	_, err := vs.CreateServer(ctx, server)
+	if appErr, ok := err.(*errors.AppError); !ok || appErr.Type != errors.ErrorTypeValidation {
+		t.Fatalf("expected copyleft license without review to be rejected, got %v", err)
+	}
+
+	// A review of a different expression does not count
+	server.LicenseReview = &models.LicenseReview{License: "MIT", Reviewer: "legal@example.com"}
+	if _, err := vs.CreateServer(ctx, server); err == nil {
+		t.Fatal("expected a review of another license to be rejected")
+	}
+
+	server.LicenseReview.License = "gpl-3.0-only"
+	if _, err := vs.CreateServer(ctx, server); err != nil {
+		t.Fatalf("expected reviewed license to be approved, got %v", err)
+	}
+
+	server = validServer("Elastic")
+	server.Status = models.StatusApproved
+	server.License = "SSPL-1.0"
+	server.LicenseReview = &models.LicenseReview{License: "SSPL-1.0", Reviewer: "legal@example.com"}
+	if _, err := vs.CreateServer(ctx, server); err == nil {
+		t.Fatal("expected forbidden license to be rejected even with a review")
+	}
+
+	// Servers that are not approved yet may carry any valid license
+	server.Status = models.StatusInReview
+	if _, err := vs.CreateServer(ctx, server); err != nil {
+		t.Fatalf("expected unapproved server to be stored, got %v", err)
+	}
+}
+
+func TestValidatingStorage_DeleteRejectsReferencedServer(t *testing.T) {
+	vs := NewValidatingStorage(NewFileStorage(t.TempDir()), license.DefaultPolicy())
+	ctx := context.Background()
+
+	target, err := vs.CreateServer(ctx, validServer("GitHub"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	server := validServer("IDP")
+	server.Relationships = []models.Relationship{{Type: models.RelationDependsOn, Target: target.ID}}
+	dependent, err := vs.CreateServer(ctx, server)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err = vs.DeleteServer(ctx, target.ID)
+	if appErr, ok := err.(*errors.AppError); !ok || appErr.Type != errors.ErrorTypeConflict {
+		t.Fatalf("expected conflict deleting a referenced server, got %v", err)
+	}
+
+	if err := vs.DeleteServer(ctx, dependent.ID); err != nil {
+		t.Fatalf("expected unreferenced server to be deleted, got %v", err)
+	}
+	if err := vs.DeleteServer(ctx, target.ID); err != nil {
+		t.Fatalf("expected server to be deleted once unreferenced, got %v", err)
+	}
+	if _, err := vs.GetServer(ctx, target.ID); err == nil {
+		t.Error("expected deleted server to be gone")
+	}
+}
@@ -2,6 +2,7 @@ package templates
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"html/template"
 	"net/http"
@@ -20,7 +21,7 @@ func InitTemplates(config models.Config) error {
 	var err error
 
 	// First, parse the layout template
-	Templates, err = template.New("layout.html").ParseFiles(filepath.Join(config.TemplatePath, "layout.html"))
+	Templates, err = template.New("layout.html").Funcs(funcMap).ParseFiles(filepath.Join(config.TemplatePath, "layout.html"))
 	if err != nil {
 		return fmt.Errorf("parsing layout template: %w", err)
 	}
@@ -35,6 +36,28 @@ func InitTemplates(config models.Config) error {
 	return nil
 }
 
+// funcMap holds the helper functions available to every template
+var funcMap = template.FuncMap{
+	// json renders a value as indented JSON, e.g. a tool's input schema
+	"json": func(v interface{}) (string, error) {
+		data, err := json.MarshalIndent(v, "", "  ")
+		return string(data), err
+	},
+	// bytes renders a size in bytes for humans, e.g. 1.4 MB
+	"bytes": func(size int64) string {
+		const unit = 1024
+		if size < unit {
+			return fmt.Sprintf("%d B", size)
+		}
+		div, exp := int64(unit), 0
+		for n := size / unit; n >= unit; n /= unit {
+			div *= unit
+			exp++
+		}
+		return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+	},
+}
+
 // ExecuteTemplate executes a template with tracing
 func ExecuteTemplate(ctx context.Context, w http.ResponseWriter, name string, data interface{}) error {
 	err := Templates.ExecuteTemplate(w, name, data)
@@ -0,0 +1,166 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// checkConfigSize keeps transport configs small enough to render and copy
+func checkConfigSize(server models.Server, c *Collector) {
+	if server.Config == nil {
+		return
+	}
+
+	data, err := json.Marshal(server.Config)
+	if err != nil {
+		c.Add("config", RuleType, "config must be valid JSON: %v", err)
+		return
+	}
+
+	if len(data) > MaxConfigBytes {
+		c.Add("config", RuleMaxSize, "config must be at most %d bytes, got %d", MaxConfigBytes, len(data))
+	}
+}
+
+// checkTransportConfig makes sure every config entry can actually be used
+// with the declared transport. Stdio servers are launched with a command;
+// remote servers need a URL, or a command that bridges to one such as
+// mcp-remote.
+func checkTransportConfig(server models.Server, c *Collector) {
+	transport := server.TransportType()
+
+	keys := make([]string, 0, len(server.Config))
+	for key := range server.Config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field := "config." + key
+
+		entry, ok := server.Config[key].(map[string]interface{})
+		if !ok {
+			c.Add(field, RuleType, "%s must be an object", field)
+			continue
+		}
+
+		command, hasCommand := entry["command"]
+		if hasCommand {
+			if value, ok := command.(string); !ok || value == "" {
+				c.Add(field+".command", RuleType, "%s.command must be a non-empty string", field)
+			}
+		}
+
+		address, hasURL := entry["url"]
+		if hasURL {
+			if value, ok := address.(string); !ok {
+				c.Add(field+".url", RuleType, "%s.url must be a string", field)
+			} else {
+				CheckURL(c, field+".url", value)
+			}
+		}
+
+		checkStringList(c, field+".args", entry["args"])
+		checkStringMap(c, field+".env", entry["env"])
+		checkStringMap(c, field+".headers", entry["headers"])
+
+		switch transport {
+		case models.TransportStdio:
+			if !hasCommand {
+				c.Add(field, RuleTransportConfig, "stdio servers must be launched with a command")
+			}
+			if hasURL {
+				c.Add(field, RuleTransportConfig, "stdio servers cannot declare a url")
+			}
+		case models.TransportSSE, models.TransportStreamableHTTP:
+			if !hasCommand && !hasURL {
+				c.Add(field, RuleTransportConfig, "%s servers need a url, or a command that bridges to one", transport)
+			}
+		}
+	}
+}
+
+func checkStringList(c *Collector, field string, value interface{}) {
+	if value == nil {
+		return
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		c.Add(field, RuleType, "%s must be a list of strings", field)
+		return
+	}
+
+	for i, item := range items {
+		if _, ok := item.(string); !ok {
+			c.Add(fmt.Sprintf("%s[%d]", field, i), RuleType, "%s must be a list of strings", field)
+		}
+	}
+}
+
+func checkStringMap(c *Collector, field string, value interface{}) {
+	if value == nil {
+		return
+	}
+
+	items, ok := value.(map[string]interface{})
+	if !ok {
+		c.Add(field, RuleType, "%s must be an object with string values", field)
+		return
+	}
+
+	for key, item := range items {
+		if _, ok := item.(string); !ok {
+			c.Add(field+"."+key, RuleType, "%s must be an object with string values", field)
+		}
+	}
+}
+
+// checkInputs validates the declared inputs and makes sure the config only
+// references inputs that have been declared
+func checkInputs(server models.Server, c *Collector) {
+	var names []string
+
+	for i, input := range server.Inputs {
+		field := fmt.Sprintf("inputs[%d]", i)
+
+		switch {
+		case input.Name == "":
+			c.Add(field+".name", RuleRequired, "input name is required")
+		case !inputNamePattern.MatchString(input.Name):
+			c.Add(field+".name", RulePattern, "input name must contain only letters, digits and . _ -")
+		case slices.Contains(names, input.Name):
+			c.Add(field+".name", RuleUnique, "input %q is declared more than once", input.Name)
+		}
+		names = append(names, input.Name)
+
+		if input.Secret && input.Default != "" {
+			c.Add(field+".default", RuleSecretDefault, "secret inputs cannot have a default value")
+		}
+
+		if input.Pattern == "" {
+			continue
+		}
+
+		// Patterns must match the whole value, the same way browsers apply them
+		pattern, err := regexp.Compile("^(?:" + input.Pattern + ")$")
+		if err != nil {
+			c.Add(field+".pattern", RulePattern, "input pattern is not a valid regular expression: %v", err)
+			continue
+		}
+		if input.Default != "" && !pattern.MatchString(input.Default) {
+			c.Add(field+".default", RulePattern, "input default does not match its pattern")
+		}
+	}
+
+	for _, name := range server.InputReferences() {
+		if !slices.Contains(names, name) {
+			c.Add("config", RuleUndeclaredInput, "config references input %q which is not declared", name)
+		}
+	}
+}
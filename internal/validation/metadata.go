@@ -0,0 +1,227 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/license"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// checkOwnership validates the enumerations, contacts and links of the
+// ownership block
+func checkOwnership(server models.Server, c *Collector) {
+	owner := server.Ownership
+	if owner == nil {
+		return
+	}
+
+	if owner.Vendor != nil {
+		if owner.Vendor.Name == "" {
+			c.Add("ownership.vendor.name", RuleRequired, "vendor name is required")
+		}
+		checkOneOf(c, "ownership.vendor.type", owner.Vendor.Type, models.VendorTypes)
+	}
+
+	if owner.SupportTier != "" {
+		checkOneOf(c, "ownership.supportTier", owner.SupportTier, models.SupportTiers)
+	}
+
+	checkContact(c, "ownership.technicalContact", owner.TechnicalContact)
+	checkContact(c, "ownership.businessContact", owner.BusinessContact)
+
+	for i, link := range owner.Documentation {
+		field := fmt.Sprintf("ownership.documentation[%d]", i)
+		if link.Title == "" {
+			c.Add(field+".title", RuleRequired, "documentation title is required")
+		}
+		if link.URL == "" {
+			c.Add(field+".url", RuleRequired, "documentation url is required")
+		} else {
+			CheckURL(c, field+".url", link.URL)
+		}
+	}
+}
+
+func checkContact(c *Collector, field string, contact *models.Contact) {
+	if contact == nil {
+		return
+	}
+
+	if contact.Name == "" && contact.Email == "" {
+		c.Add(field, RuleRequired, "contact needs a name or an email")
+	}
+	if contact.Email != "" && !emailPattern.MatchString(contact.Email) {
+		c.Add(field+".email", RuleEmail, "%s.email is not a valid email address", field)
+	}
+}
+
+// checkRisk validates the risk enumerations
+func checkRisk(server models.Server, c *Collector) {
+	risk := server.Risk
+	if risk == nil {
+		return
+	}
+
+	for i, classification := range risk.DataClassifications {
+		checkOneOf(c, fmt.Sprintf("risk.dataClassifications[%d]", i), classification, models.DataClassifications)
+	}
+	if risk.NetworkEgress != "" {
+		checkOneOf(c, "risk.networkEgress", risk.NetworkEgress, models.NetworkEgresses)
+	}
+	if risk.Authentication != "" {
+		checkOneOf(c, "risk.authentication", risk.Authentication, models.AuthenticationModels)
+	}
+	if risk.Hosting != "" {
+		checkOneOf(c, "risk.hosting", risk.Hosting, models.HostingLocations)
+	}
+}
+
+// checkTools makes sure every tool, prompt and resource template is named
+// and that names are not reused
+func checkTools(server models.Server, c *Collector) {
+	var tools []string
+	for i, tool := range server.Tools {
+		checkName(c, fmt.Sprintf("tools[%d].name", i), "tool", tool.Name, &tools)
+	}
+
+	var prompts []string
+	for i, prompt := range server.Prompts {
+		checkName(c, fmt.Sprintf("prompts[%d].name", i), "prompt", prompt.Name, &prompts)
+	}
+
+	for i, template := range server.ResourceTemplates {
+		field := fmt.Sprintf("resourceTemplates[%d]", i)
+		if template.URITemplate == "" {
+			c.Add(field+".uriTemplate", RuleRequired, "resource template uriTemplate is required")
+		}
+		if template.Name == "" {
+			c.Add(field+".name", RuleRequired, "resource template name is required")
+		}
+	}
+}
+
+// checkRelationships validates relationship types and rejects links to the
+// server itself or the same link declared twice. Whether the targets exist
+// is checked by storage.
+func checkRelationships(server models.Server, c *Collector) {
+	seen := map[[3]string]bool{}
+
+	for i, relationship := range server.Relationships {
+		field := fmt.Sprintf("relationships[%d]", i)
+
+		checkOneOf(c, field+".type", relationship.Type, models.RelationshipTypes)
+
+		if relationship.Target == "" {
+			c.Add(field+".target", RuleRequired, "relationship target is required")
+			continue
+		}
+		if server.ID != "" && relationship.Target == server.ID {
+			c.Add(field+".target", RuleReference, "a server cannot be related to itself")
+			continue
+		}
+
+		from, to, relationType := models.CanonicalRelationship(server.ID, relationship)
+		key := [3]string{from, to, relationType}
+		if seen[key] {
+			c.Add(field, RuleUnique, "relationship %s %q is declared more than once", relationship.Type, relationship.Target)
+		}
+		seen[key] = true
+	}
+}
+
+// checkLicense validates the SPDX license expression and the legal review
+// recorded for it
+func checkLicense(server models.Server, c *Collector) {
+	if server.License != "" {
+		if _, err := license.Parse(server.License); err != nil {
+			c.Add("license", RuleLicense, "%v", err)
+		}
+	}
+
+	if review := server.LicenseReview; review != nil {
+		if strings.TrimSpace(review.Reviewer) == "" {
+			c.Add("licenseReview.reviewer", RuleRequired, "license review must name the reviewer")
+		}
+		if review.License == "" {
+			c.Add("licenseReview.license", RuleRequired, "license review must name the license expression that was reviewed")
+		}
+	}
+}
+
+// CheckLicensePolicy reports why the server's license keeps it from being
+// approved: a missing or forbidden license, or one that needs a legal review
+// which has not been recorded for the current expression
+func CheckLicensePolicy(server models.Server, policy models.LicensePolicy, c *Collector) {
+	if server.License == "" {
+		c.Add("license", RuleRequired, "a license is required before a server can be approved")
+		return
+	}
+
+	expression, err := license.Parse(server.License)
+	if err != nil {
+		// Reported by checkLicense
+		return
+	}
+
+	switch license.Evaluate(expression, policy) {
+	case models.LicenseForbidden:
+		c.Add("license", RuleLicensePolicy, "license %s is forbidden by the license policy", expression)
+	case models.LicenseNeedsReview:
+		if !reviewCovers(server.LicenseReview, expression) {
+			c.Add("licenseReview", RuleLicensePolicy, "license %s needs a legal review before the server can be approved", expression)
+		}
+	}
+}
+
+// reviewCovers reports whether the review was made for the given expression
+func reviewCovers(review *models.LicenseReview, expression *license.Expression) bool {
+	if review == nil || strings.TrimSpace(review.Reviewer) == "" {
+		return false
+	}
+
+	reviewed, err := license.Parse(review.License)
+	return err == nil && reviewed.String() == expression.String()
+}
+
+// checkAttachments validates the attachment records; the content itself is
+// checked when it is uploaded
+func checkAttachments(server models.Server, c *Collector) {
+	var ids []string
+	for i, attachment := range server.Attachments {
+		field := fmt.Sprintf("attachments[%d]", i)
+
+		if attachment.ID == "" {
+			c.Add(field+".id", RuleRequired, "attachment id is required")
+		} else if slices.Contains(ids, attachment.ID) {
+			c.Add(field+".id", RuleUnique, "attachment %q is listed more than once", attachment.ID)
+		}
+		ids = append(ids, attachment.ID)
+
+		if strings.TrimSpace(attachment.Name) == "" {
+			c.Add(field+".name", RuleRequired, "attachment name is required")
+		}
+		checkOneOf(c, field+".kind", attachment.Kind, models.AttachmentKinds)
+		if !sha256Pattern.MatchString(attachment.SHA256) {
+			c.Add(field+".sha256", RulePattern, "%s.sha256 must be a lower-case hex SHA-256 digest", field)
+		}
+	}
+}
+
+func checkName(c *Collector, field, kind, name string, seen *[]string) {
+	if name == "" {
+		c.Add(field, RuleRequired, "%s name is required", kind)
+		return
+	}
+	if slices.Contains(*seen, name) {
+		c.Add(field, RuleUnique, "%s %q is declared more than once", kind, name)
+	}
+	*seen = append(*seen, name)
+}
+
+func checkOneOf(c *Collector, field, value string, allowed []string) {
+	if !slices.Contains(allowed, value) {
+		c.Add(field, RuleOneOf, "%s must be one of %s, got %q", field, strings.Join(allowed, ", "), value)
+	}
+}
@@ -0,0 +1,207 @@
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+// Limits enforced on server records
+const (
+	MaxNameLength        = 100
+	MaxDescriptionLength = 2000
+	MaxVersionLength     = 64
+	MaxConfigBytes       = 16 * 1024
+)
+
+// Rule identifiers reported in FieldError.Rule
+const (
+	RuleRequired        = "required"
+	RuleMaxLength       = "max_length"
+	RulePattern         = "pattern"
+	RuleOneOf           = "one_of"
+	RuleURL             = "url"
+	RuleEmail           = "email"
+	RuleUnique          = "unique"
+	RuleType            = "type"
+	RuleMaxSize         = "max_size"
+	RuleTransportConfig = "transport_config"
+	RuleUndeclaredInput = "undeclared_input"
+	RuleSecretDefault   = "secret_default"
+	RuleUnknownTerm     = "unknown_term"
+	RuleReference       = "reference"
+	RuleLicense         = "license"
+	RuleLicensePolicy   = "license_policy"
+)
+
+var (
+	namePattern      = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 ._()-]*$`)
+	inputNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+	emailPattern     = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	sha256Pattern    = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	versionPattern   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.+_-]*$`)
+)
+
+// FieldError describes a single violation: which field, which rule and a
+// human-readable explanation
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// Vocabulary lists the tag and category slugs servers may use. A nil
+// vocabulary skips the taxonomy checks.
+type Vocabulary struct {
+	Tags       []string
+	Categories []string
+}
+
+// Collector gathers violations so that every problem is reported at once
+type Collector struct {
+	errs []FieldError
+}
+
+// Add records a violation
+func (c *Collector) Add(field, rule, format string, args ...any) {
+	c.errs = append(c.errs, FieldError{Field: field, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+// Errors returns the violations recorded so far
+func (c *Collector) Errors() []FieldError {
+	return c.errs
+}
+
+// Err returns a validation error listing every violation, or nil
+func (c *Collector) Err() error {
+	if len(c.errs) == 0 {
+		return nil
+	}
+	return errors.NewValidationError(fmt.Sprintf("Server failed validation with %d error(s)", len(c.errs)), c.errs)
+}
+
+// rule inspects a server and reports every violation it finds
+type rule func(server models.Server, c *Collector)
+
+// serverRules lists every check a server must pass before it is written
+var serverRules = []rule{
+	required("name", serverName),
+	maxLength("name", MaxNameLength, serverName),
+	matches("name", namePattern, "must start with a letter or digit and contain only letters, digits, spaces and . _ - ( )", serverName),
+	required("description", serverDescription),
+	maxLength("description", MaxDescriptionLength, serverDescription),
+	maxLength("version", MaxVersionLength, serverVersion),
+	matches("version", versionPattern, "must start with a letter or digit and contain only letters, digits and . + _ -", serverVersion),
+	required("status", serverStatus),
+	oneOf("status", models.Statuses, serverStatus),
+	required("transport", serverTransport),
+	oneOf("transport", models.Transports, serverTransport),
+	optionalURL("url", serverURL),
+	checkConfigSize,
+	checkTransportConfig,
+	checkInputs,
+	checkOwnership,
+	checkRisk,
+	checkTools,
+	checkRelationships,
+	checkLicense,
+	checkAttachments,
+}
+
+func serverName(s models.Server) string        { return s.Name }
+func serverDescription(s models.Server) string { return s.Description }
+func serverVersion(s models.Server) string     { return s.Version }
+func serverStatus(s models.Server) string      { return s.Status }
+func serverTransport(s models.Server) string   { return s.TransportType() }
+func serverURL(s models.Server) string         { return s.URL }
+
+// ValidateServer runs every rule against the server and returns a validation
+// error whose details list all violations, or nil if the server is valid
+func ValidateServer(server models.Server, vocabulary *Vocabulary) error {
+	c := &Collector{}
+
+	for _, rule := range serverRules {
+		rule(server, c)
+	}
+
+	if vocabulary != nil {
+		checkTerms(c, "tags", server.Tags, vocabulary.Tags)
+		checkTerms(c, "categories", server.Categories, vocabulary.Categories)
+	}
+
+	return c.Err()
+}
+
+func required(field string, value func(models.Server) string) rule {
+	return func(server models.Server, c *Collector) {
+		if strings.TrimSpace(value(server)) == "" {
+			c.Add(field, RuleRequired, "%s is required", field)
+		}
+	}
+}
+
+func maxLength(field string, limit int, value func(models.Server) string) rule {
+	return func(server models.Server, c *Collector) {
+		if n := len([]rune(value(server))); n > limit {
+			c.Add(field, RuleMaxLength, "%s must be at most %d characters, got %d", field, limit, n)
+		}
+	}
+}
+
+func matches(field string, pattern *regexp.Regexp, explanation string, value func(models.Server) string) rule {
+	return func(server models.Server, c *Collector) {
+		if v := value(server); v != "" && !pattern.MatchString(v) {
+			c.Add(field, RulePattern, "%s %s", field, explanation)
+		}
+	}
+}
+
+func oneOf(field string, allowed []string, value func(models.Server) string) rule {
+	return func(server models.Server, c *Collector) {
+		if v := value(server); v != "" && !slices.Contains(allowed, v) {
+			c.Add(field, RuleOneOf, "%s must be one of %s, got %q", field, strings.Join(allowed, ", "), v)
+		}
+	}
+}
+
+func optionalURL(field string, value func(models.Server) string) rule {
+	return func(server models.Server, c *Collector) {
+		if v := value(server); v != "" {
+			CheckURL(c, field, v)
+		}
+	}
+}
+
+// CheckURL reports a violation unless value is an absolute http or https URL.
+// Input placeholders are allowed and stand in for the part they replace.
+func CheckURL(c *Collector, field, value string) {
+	resolved := models.ReplaceInputPlaceholders(value, func(string) string { return "https://placeholder" }).(string)
+	if resolved == "https://placeholder" {
+		return
+	}
+
+	parsed, err := url.Parse(resolved)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		c.Add(field, RuleURL, "%s must be an absolute http or https URL", field)
+	}
+}
+
+func checkTerms(c *Collector, field string, values, known []string) {
+	seen := map[string]bool{}
+	for i, value := range values {
+		path := fmt.Sprintf("%s[%d]", field, i)
+		if seen[value] {
+			c.Add(path, RuleUnique, "%s %q is listed more than once", field, value)
+		}
+		seen[value] = true
+
+		if !slices.Contains(known, value) {
+			c.Add(path, RuleUnknownTerm, "%q is not in the managed %s vocabulary", value, field)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package validation
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bear-belly/mcp-registry/internal/errors"
+	"github.com/bear-belly/mcp-registry/internal/license"
+	"github.com/bear-belly/mcp-registry/internal/models"
+)
+
+func validServer() models.Server {
+	return models.Server{
+		Name:        "Example",
+		Description: "An example server",
+		Transport:   "stdio",
+		Status:      models.StatusNew,
+		URL:         "https://example.com/mcp",
+		Inputs:      []models.Input{{Name: "token", Secret: true, Required: true}},
+		Config: map[string]interface{}{
+			"example": map[string]interface{}{
+				"command": "npx",
+				"args":    []interface{}{"-y", "example-mcp"},
+				"env":     map[string]interface{}{"EXAMPLE_TOKEN": "${input:token}"},
+			},
+		},
+	}
+}
+
+// fieldErrors unwraps the details of a validation error
+func fieldErrors(t *testing.T, err error) []FieldError {
+	t.Helper()
+
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		t.Fatalf("expected *errors.AppError, got %T (%v)", err, err)
+	}
+	if appErr.Type != errors.ErrorTypeValidation {
+		t.Fatalf("expected validation error, got %s", appErr.Type)
+	}
+
+	details, ok := appErr.Details.([]FieldError)
+	if !ok {
+		t.Fatalf("expected []FieldError details, got %T", appErr.Details)
+	}
+	return details
+}
+
+func hasError(details []FieldError, field, rule string) bool {
+	for _, detail := range details {
+		if detail.Field == field && detail.Rule == rule {
+			return true
+		}
+	}
+	return false
+}
+
+func TestValidateServer_Valid(t *testing.T) {
+	if err := ValidateServer(validServer(), nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateServer_ReportsEveryViolation(t *testing.T) {
+	details := fieldErrors(t, ValidateServer(models.Server{}, nil))
+
+	for _, field := range []string{"name", "description", "status", "transport"} {
+		if !hasError(details, field, RuleRequired) {
+			t.Errorf("expected %s to be reported as required, got %+v", field, details)
+		}
+	}
+}
+
+func TestValidateServer_Rules(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(server *models.Server)
+		field  string
+		rule   string
+	}{
+		{"name characters", func(s *models.Server) { s.Name = "../etc/passwd" }, "name", RulePattern},
+		{"name length", func(s *models.Server) { s.Name = strings.Repeat("a", MaxNameLength+1) }, "name", RuleMaxLength},
+		{"status", func(s *models.Server) { s.Status = "done" }, "status", RuleOneOf},
+		{"url", func(s *models.Server) { s.URL = "github.com/example" }, "url", RuleURL},
+		{"stdio with url", func(s *models.Server) {
+			s.Config["example"].(map[string]interface{})["url"] = "https://example.com"
+		}, "config.example", RuleTransportConfig},
+		{"remote without url", func(s *models.Server) {
+			s.Transport = "streamable-http"
+			delete(s.Config["example"].(map[string]interface{}), "command")
+		}, "config.example", RuleTransportConfig},
+		{"args type", func(s *models.Server) {
+			s.Config["example"].(map[string]interface{})["args"] = "-y"
+		}, "config.example.args", RuleType},
+		{"config size", func(s *models.Server) {
+			s.Config["example"].(map[string]interface{})["args"] = []interface{}{strings.Repeat("x", MaxConfigBytes)}
+		}, "config", RuleMaxSize},
+		{"undeclared input", func(s *models.Server) { s.Inputs = nil }, "config", RuleUndeclaredInput},
+		{"secret default", func(s *models.Server) { s.Inputs[0].Default = "hunter2" }, "inputs[0].default", RuleSecretDefault},
+		{"input pattern", func(s *models.Server) { s.Inputs[0].Pattern = "([a-z" }, "inputs[0].pattern", RulePattern},
+		{"contact email", func(s *models.Server) {
+			s.Ownership = &models.Ownership{TechnicalContact: &models.Contact{Email: "not-an-email"}}
+		}, "ownership.technicalContact.email", RuleEmail},
+		{"risk hosting", func(s *models.Server) { s.Risk = &models.Risk{Hosting: "moon"} }, "risk.hosting", RuleOneOf},
+		{"duplicate tool", func(s *models.Server) {
+			s.Tools = []models.Tool{{Name: "search"}, {Name: "search"}}
+		}, "tools[1].name", RuleUnique},
+		{"relationship type", func(s *models.Server) {
+			s.Relationships = []models.Relationship{{Type: "forks", Target: "other"}}
+		}, "relationships[0].type", RuleOneOf},
+		{"license expression", func(s *models.Server) { s.License = "MIT OR" }, "license", RuleLicense},
+		{"license reviewer", func(s *models.Server) {
+			s.LicenseReview = &models.LicenseReview{License: "MIT"}
+		}, "licenseReview.reviewer", RuleRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := validServer()
+			tt.modify(&server)
+
+			details := fieldErrors(t, ValidateServer(server, nil))
+			if !hasError(details, tt.field, tt.rule) {
+				t.Errorf("expected %s violation on %s, got %+v", tt.rule, tt.field, details)
+			}
+		})
+	}
+}
+
+func TestValidateServer_Vocabulary(t *testing.T) {
+	server := validServer()
+	server.Tags = []string{"known", "unknown"}
+
+	details := fieldErrors(t, ValidateServer(server, &Vocabulary{Tags: []string{"known"}}))
+	if !hasError(details, "tags[1]", RuleUnknownTerm) {
+		t.Errorf("expected unknown tag to be reported, got %+v", details)
+	}
+}
+
+// TestValidateServer_SampleData keeps the records shipped in data/ valid
+func TestValidateServer_SampleData(t *testing.T) {
+	dataDir := filepath.Join("..", "..", "data")
+
+	vocabulary := &Vocabulary{
+		Tags:       readSlugs(t, filepath.Join(dataDir, "tags")),
+		Categories: readSlugs(t, filepath.Join(dataDir, "categories")),
+	}
+
+	files, err := filepath.Glob(filepath.Join(dataDir, "*.json"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("expected sample data, got %v (%v)", files, err)
+	}
+
+	for _, file := range files {
+		var server models.Server
+		readJSON(t, file, &server)
+
+		if err := ValidateServer(server, vocabulary); err != nil {
+			t.Errorf("%s: %v %+v", file, err, err.(*errors.AppError).Details)
+		}
+
+		if server.Status == models.StatusApproved {
+			c := &Collector{}
+			CheckLicensePolicy(server, license.DefaultPolicy(), c)
+			if len(c.Errors()) > 0 {
+				t.Errorf("%s: approved server violates the license policy: %+v", file, c.Errors())
+			}
+		}
+	}
+}
+
+func readSlugs(t *testing.T, dir string) []string {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var slugs []string
+	for _, file := range files {
+		var term models.Tag
+		readJSON(t, file, &term)
+		slugs = append(slugs, term.Slug)
+	}
+	return slugs
+}
+
+func readJSON(t *testing.T, file string, v any) {
+	t.Helper()
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(content, v); err != nil {
+		t.Fatalf("%s: %v", file, err)
+	}
+}
@@ -0,0 +1,392 @@
+// Package webhooks delivers catalog events to the webhooks admins have
+// registered. Every delivery is logged with each attempt made, retried with
+// exponential backoff while it fails, and dead-lettered once the attempts
+// run out.
+//
+// Requests are signed so that receivers can check where they came from:
+// X-Webhook-Signature is "sha256=" followed by the hex HMAC-SHA256, keyed
+// with the webhook's secret, of X-Webhook-Timestamp, a dot and the body.
+// Receivers should also reject timestamps too far in the past.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/events"
+	"github.com/bear-belly/mcp-registry/internal/logger"
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/storage"
+)
+
+// Request headers of a delivery
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// PingEvent is the type of the test event sent on request
+const PingEvent = "webhook.ping"
+
+// EventsMissedEvent is the type of the notice sent to every webhook when the
+// dispatcher fell so far behind that events were lost before they could be
+// delivered. Its data holds afterEventId, the last event delivered before
+// the gap; receivers should resynchronise from the catalog.
+const EventsMissedEvent = "webhook.events_missed"
+
+// maxResponseBody is how much of a receiver's response is kept in the log
+const maxResponseBody = 1024
+
+// Policy decides how often and how far apart a delivery is attempted.
+// Attempt n waits InitialBackoff * 2^(n-1), up to MaxBackoff.
+type Policy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Timeout        time.Duration
+}
+
+// DefaultPolicy retries for about a day before giving up
+var DefaultPolicy = Policy{
+	MaxAttempts:    10,
+	InitialBackoff: 30 * time.Second,
+	MaxBackoff:     4 * time.Hour,
+	Timeout:        10 * time.Second,
+}
+
+// backoff is the wait after the given number of failed attempts
+func (p Policy) backoff(attempts int) time.Duration {
+	wait := p.InitialBackoff
+	for i := 1; i < attempts && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, p.MaxBackoff)
+}
+
+// Dispatcher turns published events into deliveries and makes them
+type Dispatcher struct {
+	store  storage.WebhookStorage
+	client *http.Client
+	policy Policy
+
+	ctx context.Context
+	mu  sync.Mutex
+	// timers holds the scheduled retry of each pending delivery
+	timers map[string]*time.Timer
+}
+
+// NewDispatcher creates a dispatcher that sends requests with client
+func NewDispatcher(store storage.WebhookStorage, client *http.Client, policy Policy) *Dispatcher {
+	return &Dispatcher{
+		store:  store,
+		client: client,
+		policy: policy,
+		ctx:    context.Background(),
+		timers: map[string]*time.Timer{},
+	}
+}
+
+// Run schedules the deliveries left pending by a previous run, then delivers
+// the broker's events until ctx is done
+func (d *Dispatcher) Run(ctx context.Context, broker *events.Broker) {
+	d.mu.Lock()
+	d.ctx = ctx
+	d.mu.Unlock()
+
+	if err := d.resume(ctx); err != nil {
+		logger.Error("Failed to resume pending webhook deliveries", "error", err)
+	}
+
+	lastEventID := ""
+	for {
+		replay, feed, cancel, complete := broker.Subscribe(lastEventID)
+		if !complete {
+			d.reportGap(ctx, lastEventID)
+		}
+		for _, event := range replay {
+			d.dispatch(ctx, event)
+			lastEventID = event.ID
+		}
+
+		for open := true; open; {
+			select {
+			case <-ctx.Done():
+				cancel()
+				d.stopTimers()
+				return
+			case event, ok := <-feed:
+				if open = ok; ok {
+					d.dispatch(ctx, event)
+					lastEventID = event.ID
+				}
+			}
+		}
+		// Fell behind the broker; subscribe again and catch up from the
+		// replay buffer
+		cancel()
+	}
+}
+
+// dispatch creates a delivery of the event for every webhook that wants it
+// and makes the first attempt in the background
+func (d *Dispatcher) dispatch(ctx context.Context, event events.Event) {
+	webhooks, err := d.store.ListWebhooks(ctx)
+	if err != nil {
+		logger.Error("Failed to list webhooks", "event", event.ID, "error", err)
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.Error("Failed to encode event", "event", event.ID, "error", err)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Wants(event.Type) {
+			continue
+		}
+		delivery, err := d.create(ctx, webhook.ID, event.ID, event.Type, payload, "")
+		if err != nil {
+			logger.Error("Failed to log webhook delivery", "webhook", webhook.ID, "error", err)
+			continue
+		}
+		go d.Attempt(ctx, delivery)
+	}
+}
+
+// reportGap logs that the events after lastEventID were lost and sends an
+// EventsMissedEvent to every webhook, whatever events it subscribes to, so
+// that the gap shows in each delivery log and reaches the receivers
+func (d *Dispatcher) reportGap(ctx context.Context, lastEventID string) {
+	logger.Error("Webhook dispatcher fell behind the event buffer; events were not delivered", "afterEventId", lastEventID)
+
+	webhooks, err := d.store.ListWebhooks(ctx)
+	if err != nil {
+		logger.Error("Failed to list webhooks", "error", err)
+		return
+	}
+
+	id := models.NewID()
+	payload, err := json.Marshal(map[string]any{
+		"id":   id,
+		"type": EventsMissedEvent,
+		"time": time.Now().UTC(),
+		"data": map[string]string{"afterEventId": lastEventID},
+	})
+	if err != nil {
+		logger.Error("Failed to encode event", "event", id, "error", err)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		delivery, err := d.create(ctx, webhook.ID, id, EventsMissedEvent, payload, "")
+		if err != nil {
+			logger.Error("Failed to log webhook delivery", "webhook", webhook.ID, "error", err)
+			continue
+		}
+		go d.Attempt(ctx, delivery)
+	}
+}
+
+// Ping delivers a test event to a webhook and returns the delivery after its
+// first attempt
+func (d *Dispatcher) Ping(ctx context.Context, webhook models.Webhook) (models.Delivery, error) {
+	id := models.NewID()
+	payload, err := json.Marshal(map[string]any{
+		"id":   id,
+		"type": PingEvent,
+		"time": time.Now().UTC(),
+		"data": map[string]string{"webhookId": webhook.ID},
+	})
+	if err != nil {
+		return models.Delivery{}, err
+	}
+
+	delivery, err := d.create(ctx, webhook.ID, id, PingEvent, payload, "")
+	if err != nil {
+		return models.Delivery{}, err
+	}
+	return d.Attempt(ctx, delivery), nil
+}
+
+// Replay sends the payload of an earlier delivery again as a new delivery,
+// with attempts of its own, and returns it after its first attempt
+func (d *Dispatcher) Replay(ctx context.Context, original models.Delivery) (models.Delivery, error) {
+	delivery, err := d.create(ctx, original.WebhookID, original.EventID, original.EventType, original.Payload, original.ID)
+	if err != nil {
+		return models.Delivery{}, err
+	}
+	return d.Attempt(ctx, delivery), nil
+}
+
+func (d *Dispatcher) create(ctx context.Context, webhookID, eventID, eventType string, payload []byte, replayOf string) (models.Delivery, error) {
+	now := time.Now().UTC()
+	delivery := models.Delivery{
+		ID:            models.NewID(),
+		WebhookID:     webhookID,
+		EventID:       eventID,
+		EventType:     eventType,
+		ReplayOf:      replayOf,
+		Payload:       payload,
+		Status:        models.DeliveryPending,
+		Attempts:      []models.DeliveryAttempt{},
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	return delivery, d.store.SaveDelivery(ctx, delivery)
+}
+
+// Attempt makes the next attempt of a pending delivery, records it and
+// either schedules a retry or settles the delivery, which it returns
+func (d *Dispatcher) Attempt(ctx context.Context, delivery models.Delivery) models.Delivery {
+	webhook, err := d.store.GetWebhook(ctx, delivery.WebhookID)
+	if err != nil {
+		// The webhook was deleted along with its log
+		return delivery
+	}
+
+	attempt := d.send(ctx, webhook, delivery)
+	delivery.Attempts = append(delivery.Attempts, attempt)
+	delivery.UpdatedAt = time.Now().UTC()
+	delivery.NextAttemptAt = nil
+
+	switch {
+	case attempt.StatusCode >= 200 && attempt.StatusCode < 300:
+		delivery.Status = models.DeliveryDelivered
+	case len(delivery.Attempts) >= d.policy.MaxAttempts:
+		delivery.Status = models.DeliveryDeadLetter
+	default:
+		next := delivery.UpdatedAt.Add(d.policy.backoff(len(delivery.Attempts)))
+		delivery.NextAttemptAt = &next
+	}
+
+	if err := d.store.SaveDelivery(ctx, delivery); err != nil {
+		logger.Error("Failed to log webhook delivery", "delivery", delivery.ID, "error", err)
+	}
+	if delivery.Status == models.DeliveryPending {
+		d.schedule(delivery)
+	}
+	return delivery
+}
+
+// send makes one signed request for a delivery
+func (d *Dispatcher) send(ctx context.Context, webhook models.Webhook, delivery models.Delivery) models.DeliveryAttempt {
+	start := time.Now()
+	attempt := models.DeliveryAttempt{At: start.UTC()}
+
+	ctx, cancel := context.WithTimeout(ctx, d.policy.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+
+	timestamp := start.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "mcp-registry-webhooks")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	attempt.DurationMs = time.Since(start).Milliseconds()
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	attempt.StatusCode = resp.StatusCode
+	attempt.ResponseBody = string(body)
+	return attempt
+}
+
+// schedule arranges the next attempt of a pending delivery
+func (d *Dispatcher) schedule(delivery models.Delivery) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.ctx.Err() != nil {
+		return
+	}
+	if timer, ok := d.timers[delivery.ID]; ok {
+		timer.Stop()
+	}
+
+	ctx := d.ctx
+	d.timers[delivery.ID] = time.AfterFunc(time.Until(*delivery.NextAttemptAt), func() {
+		d.mu.Lock()
+		delete(d.timers, delivery.ID)
+		d.mu.Unlock()
+
+		// Pick up the latest record, in case it changed since
+		current, err := d.store.GetDelivery(ctx, delivery.WebhookID, delivery.ID)
+		if err == nil && current.Status == models.DeliveryPending {
+			d.Attempt(ctx, current)
+		}
+	})
+}
+
+// resume schedules every delivery that was still pending when the previous
+// run stopped. Overdue ones are attempted straight away.
+func (d *Dispatcher) resume(ctx context.Context) error {
+	webhooks, err := d.store.ListWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		deliveries, err := d.store.ListDeliveries(ctx, webhook.ID)
+		if err != nil {
+			return err
+		}
+		for _, delivery := range deliveries {
+			if delivery.Status != models.DeliveryPending {
+				continue
+			}
+			if delivery.NextAttemptAt == nil {
+				now := time.Now()
+				delivery.NextAttemptAt = &now
+			}
+			d.schedule(delivery)
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) stopTimers() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for id, timer := range d.timers {
+		timer.Stop()
+		delete(d.timers, id)
+	}
+}
+
+// Sign computes the X-Webhook-Signature of a payload
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,180 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bear-belly/mcp-registry/internal/events"
+	"github.com/bear-belly/mcp-registry/internal/models"
+	"github.com/bear-belly/mcp-registry/internal/storage"
+)
+
+var testPolicy = Policy{MaxAttempts: 3, InitialBackoff: 10 * time.Millisecond, MaxBackoff: 40 * time.Millisecond, Timeout: time.Second}
+
+// standIn is a receiver that verifies signatures and fails the first
+// failures requests
+func standIn(t *testing.T, secret string, failures int32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		if r.Header.Get(HeaderSignature) != Sign(secret, timestamp, body) {
+			t.Errorf("signature does not match the body")
+		}
+		if calls.Add(1) <= failures {
+			http.Error(w, "not yet", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func waitForStatus(t *testing.T, store storage.WebhookStorage, webhookID, status string) models.Delivery {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		deliveries, err := store.ListDeliveries(context.Background(), webhookID)
+		if err != nil {
+			t.Fatalf("listing deliveries: %v", err)
+		}
+		if len(deliveries) == 1 && deliveries[0].Status == status {
+			return deliveries[0]
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("no delivery reached %s", status)
+	return models.Delivery{}
+}
+
+func TestDispatcher_RetriesUntilDelivered(t *testing.T) {
+	store := storage.NewFileStorage(t.TempDir())
+	srv, calls := standIn(t, "s3cret", 2)
+	webhook := models.Webhook{ID: "hook", URL: srv.URL, Secret: "s3cret", Events: []string{events.ServerStatusChanged}}
+	if err := store.SaveWebhook(context.Background(), webhook); err != nil {
+		t.Fatalf("saving webhook: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	broker := events.NewBroker(10)
+	go NewDispatcher(store, srv.Client(), testPolicy).Run(ctx, broker)
+	time.Sleep(20 * time.Millisecond)
+
+	broker.Publish(events.ServerUpdated, events.ServerChange{ID: "ignored"})
+	broker.Publish(events.ServerStatusChanged, events.ServerChange{ID: "a", Status: models.StatusRevoked})
+
+	delivery := waitForStatus(t, store, webhook.ID, models.DeliveryDelivered)
+	if len(delivery.Attempts) != 3 || calls.Load() != 3 {
+		t.Errorf("expected two failed attempts and a delivered one, got %+v", delivery.Attempts)
+	}
+	if delivery.EventType != events.ServerStatusChanged || delivery.Attempts[0].StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("unexpected delivery %+v", delivery)
+	}
+}
+
+func TestDispatcher_DeadLettersAndReplays(t *testing.T) {
+	store := storage.NewFileStorage(t.TempDir())
+	srv, calls := standIn(t, "s3cret", 3)
+	webhook := models.Webhook{ID: "hook", URL: srv.URL, Secret: "s3cret"}
+	store.SaveWebhook(context.Background(), webhook)
+
+	dispatcher := NewDispatcher(store, srv.Client(), testPolicy)
+	first, err := dispatcher.Ping(context.Background(), webhook)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if first.Status != models.DeliveryPending || first.NextAttemptAt == nil {
+		t.Fatalf("expected a retry to be scheduled, got %+v", first)
+	}
+
+	dead := waitForStatus(t, store, webhook.ID, models.DeliveryDeadLetter)
+	if len(dead.Attempts) != testPolicy.MaxAttempts || dead.NextAttemptAt != nil {
+		t.Errorf("expected %d attempts and no retry, got %+v", testPolicy.MaxAttempts, dead)
+	}
+
+	replayed, err := dispatcher.Replay(context.Background(), dead)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if replayed.Status != models.DeliveryDelivered || replayed.ReplayOf != dead.ID || string(replayed.Payload) != string(dead.Payload) {
+		t.Errorf("expected a delivered copy of the dead letter, got %+v", replayed)
+	}
+	if calls.Load() != 4 {
+		t.Errorf("expected 4 requests, got %d", calls.Load())
+	}
+}
+
+// stalledStorage holds up the first ListWebhooks call after it is armed
+// until release is closed, so that the dispatcher falls behind the broker
+type stalledStorage struct {
+	storage.WebhookStorage
+	armed   atomic.Bool
+	release chan struct{}
+}
+
+func (s *stalledStorage) ListWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	if s.armed.CompareAndSwap(true, false) {
+		<-s.release
+	}
+	return s.WebhookStorage.ListWebhooks(ctx)
+}
+
+func TestDispatcher_ReportsMissedEvents(t *testing.T) {
+	files := storage.NewFileStorage(t.TempDir())
+	srv, _ := standIn(t, "s3cret", 0)
+	webhook := models.Webhook{ID: "hook", URL: srv.URL, Secret: "s3cret", Events: []string{events.ServerStatusChanged}}
+	if err := files.SaveWebhook(context.Background(), webhook); err != nil {
+		t.Fatalf("saving webhook: %v", err)
+	}
+	store := &stalledStorage{WebhookStorage: files, release: make(chan struct{})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	broker := events.NewBroker(10)
+	go NewDispatcher(store, srv.Client(), testPolicy).Run(ctx, broker)
+	time.Sleep(20 * time.Millisecond)
+	store.armed.Store(true)
+
+	// The first event stalls the dispatcher; the rest overflow both its
+	// subscription and the replay buffer
+	for i := 0; i < 200; i++ {
+		broker.Publish(events.ServerUpdated, events.ServerChange{ID: "a"})
+	}
+	close(store.release)
+
+	delivery := waitForStatus(t, files, webhook.ID, models.DeliveryDelivered)
+	if delivery.EventType != EventsMissedEvent {
+		t.Fatalf("expected a %s delivery, got %+v", EventsMissedEvent, delivery)
+	}
+	var payload struct {
+		Type string `json:"type"`
+		Data struct {
+			AfterEventID string `json:"afterEventId"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(delivery.Payload, &payload); err != nil {
+		t.Fatalf("decoding payload: %v", err)
+	}
+	if payload.Type != EventsMissedEvent || payload.Data.AfterEventID == "" {
+		t.Errorf("expected the notice to name the last delivered event, got %s", delivery.Payload)
+	}
+}
+
+func TestPolicy_Backoff(t *testing.T) {
+	p := Policy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	for attempts, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 20: 5 * time.Second} {
+		if got := p.backoff(attempts); got != want {
+			t.Errorf("after %d attempts expected %v, got %v", attempts, want, got)
+		}
+	}
+}